@@ -12,8 +12,15 @@ import (
 	"errors"
 	"sync"
 	"strconv"
+	"strings"
 	"os"
 	"time"
+	"io/ioutil"
+	"math/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"regexp"
 )
 
 const (
@@ -22,41 +29,48 @@ const (
 	RECOVERING
 )
 
+const purgeInterval = 10 * time.Second
+
 // server is used to implement helloworld.GreeterServer.
 
 type server struct {
 	mu             sync.Mutex // Lock to protect shared access to this peer's state
+	opMu           sync.Mutex // serializes replicated operations on the primary, so every server applies them in the same order
+	applyMu        sync.Mutex // held by a backup while it applies an operation of the primary or repairs divergent state
 	peers          []string   // Ports of all peers
 	peerRPC        [3]pb.GreeterClient
 	me             int      // this peer's index into peers[]
 	currentView    int      // what this peer believes to be the current active view
 	status         int      // the server's current status (NORMAL, VIEWCHANGE or RECOVERING)
 	lastNormalView int      // the latest view which had a NORMAL status
-	log            []string // the log of "commands"
+	log            []*pb.LogEntry // the log of "commands", each entry's hash is chained to the previous one
 	commitIndex    int      // all log entries <= commitIndex are considered to have been committed.
 	opNo           int
+	applied        int      // on a backup, the number of the latest operation of the primary it applied
+	recoveryNonce  int64    // nonce of the recovery in progress, 0 when the server is not recovering
+	stateFile      string   // file where the view numbers are persisted across restarts
+	store          Store    // the users, tweets and follow edges
+	blobs          *blobStore // the images referenced by users and tweets
 }
 
 // SayHello implements helloworld.GreeterServer
 
-//userdata
-var userdata = make(map[string]User)
+//debugfuntion
+var debugon = true //if set to true debug outputs are printed
 
-type User struct {
-	username string
-	password string
-	tweets   []tweet
-	follows  map[string]bool
-}
+//storage engine flags
+var storeEngine = flag.String("store", "memory", "storage engine for user data: memory or bolt")
+var boltFile = flag.String("boltfile", "", "database file of the bolt storage engine (default replica<ServerID>.db)")
 
-type tweet struct {
-	text string
-}
+//directory of the images, each server keeps its own copy
+var blobDir = flag.String("blobdir", "", "directory of the stored images (default replica<ServerID>.blobs)")
 
-//userdataend
+//largest message exchanged with other servers and the FE. The state sent to a recovering server includes the
+//images, so it is far above the gRPC default of 4MB
+const maxMessageSize = 1 << 30
 
-//debugfuntion
-var debugon = true //if set to true debug outputs are printed
+//time a deleted account can be restored before it is purged, 0 purges accounts right away
+var deleteGrace = flag.Duration("deletegrace", 0, "time a deleted account can be restored before it is purged")
 
 //Function to print debug outputs if debugon=true
 func debugPrint(text string) {
@@ -75,10 +89,28 @@ func (s *server) SayHelloAgain(ctx context.Context, in *pb.HelloRequest) (*pb.He
 	return &pb.HelloReply{Message: "Hello again friend " + in.Name}, nil
 }
 
+//usernamePattern is what a username may look like, the pages print usernames and put them in links and mentions
+//only match word characters
+var usernamePattern = regexp.MustCompile(`^\w+$`)
+
+var errBadUsername = errors.New("username may only contain letters, digits and underscores")
+
 //registeruser function
 func (s *server) Register(ctx context.Context, in *pb.Credentials) (*pb.RegisterReply, error) {
 
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Register operation, server is recovering")
+		return &pb.RegisterReply{Message: "Error: Server is recovering"}, errors.New("server is recovering")
+	}
+
 	if in.Broadcast == true {
+		//Checked by the primary only, so operations logged before the check still apply
+		if !usernamePattern.MatchString(in.Uname) {
+			return &pb.RegisterReply{Message: "Error: Invalid username"}, errBadUsername
+		}
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
 		//index, view, ok := s.Start(in.String())
 		//println(in.String())
 		index, _, ok := s.Start(in.String())
@@ -103,7 +135,7 @@ func (s *server) Register(ctx context.Context, in *pb.Credentials) (*pb.Register
 		}
 		if count >= len(s.peers)/2 {
 			fmt.Println("Debug: Replication on backup servers acheived")
-			s.commitIndex = index
+			s.setCommitIndex(index)
 		} else {
 			fmt.Printf("Error: Replication failed, replicated only on %d servers", count+1)
 			//TODO: Return here?
@@ -113,25 +145,39 @@ func (s *server) Register(ctx context.Context, in *pb.Credentials) (*pb.Register
 	usrname := in.Uname
 	pwd := in.Pwd
 
-	_, ok := userdata[usrname]
-	if ok {
+	err := s.store.Update(func(tx *Tx) error {
+		if _, ok := tx.User(usrname); ok {
+			return errUserExists
+		}
+		return tx.PutUser(User{Username: usrname, Password: pwd})
+	})
+	if err == errUserExists {
 		debugPrint("Debug: User already exists")
 		return &pb.RegisterReply{Message: "User already exists"}, errors.New("user already exists")
+	} else if err != nil {
+		fmt.Printf("Error: Could not add user %s: %s \n", usrname, err)
+		return &pb.RegisterReply{Message: "Error: Could not add user"}, err
 	}
-	usr := User{username: usrname, password: pwd}
-	usr.follows = make(map[string]bool)
-	userdata[usrname] = usr
 	fmt.Printf("Debug: User %s successfully added \n",usrname)
 	return &pb.RegisterReply{Message: "User succesfully added"}, nil
 }
 
 func (s *server) Login(ctx context.Context, in *pb.Credentials) (*pb.LoginReply, error) {
-	user, ok := userdata[in.Uname]
+	var user User
+	var ok bool
+	s.store.View(func(tx *Tx) error {
+		user, ok = tx.User(in.Uname)
+		return nil
+	})
 	if !ok {
 		debugPrint("Debug: No such user")
 		return &pb.LoginReply{Status: false}, errors.New("no such User")
 	}
-	if in.Pwd == user.password {
+	if user.DeletedAt != 0 {
+		debugPrint("Debug: Account is deleted")
+		return &pb.LoginReply{Status: false}, errAccountDeleted
+	}
+	if in.Pwd == user.Password {
 		return &pb.LoginReply{Status: true}, nil
 	} else {
 		debugPrint("Debug: Wrong password")
@@ -141,9 +187,60 @@ func (s *server) Login(ctx context.Context, in *pb.Credentials) (*pb.LoginReply,
 
 func (s *server) AddTweet(ctx context.Context, in *pb.AddTweetRequest) (*pb.AddTweetReply, error) {
 
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Add Tweet operation, server is recovering")
+		return &pb.AddTweetReply{Status: false}, errors.New("server is recovering")
+	}
+
 	// Will be Broadcasted to all the other servers
 	//println(in.String())
 	if in.Broadcast == true {
+		//The operation is applied locally after it was sent to the backups, so the lock is held until we return
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//A scheduled tweet is published once, a tweet publishing one which is no longer queued is not logged
+		if in.ScheduledId != 0 {
+			var queued bool
+			s.store.View(func(tx *Tx) error {
+				_, queued = tx.ScheduledTweet(in.Username, in.ScheduledId)
+				return nil
+			})
+			if !queued {
+				return &pb.AddTweetReply{Status: false}, errNoSuchScheduledTweet
+			}
+		}
+
+		//A reply to a tweet which is gone fails everywhere, so it is not logged. A reply to a retweet is
+		//logged as a reply to the original tweet
+		if in.ReplyTo != 0 {
+			err := s.store.View(func(tx *Tx) error {
+				if _, ok := tx.ActiveUser(in.Username); !ok {
+					return errNoSuchUser
+				}
+				parent, err := tx.replyTarget(in.Username, in.ReplyTo)
+				in.ReplyTo = parent.ID
+				return err
+			})
+			if err != nil {
+				return &pb.AddTweetReply{Status: false}, err
+			}
+		}
+
+		//Attached images have to be uploaded first, the operation only carries their blob IDs
+		if err := s.checkAttachments(in.Media, in.PollOptions, in.PollDuration); err != nil {
+			return &pb.AddTweetReply{Status: false}, err
+		}
+
+		//The tweet's ID and creation time are fixed before it is logged, so every server stores the same tweet.
+		//opMu is held, so the next op number is the one Start will log the tweet at. The ID is also the one of
+		//the notifications about the tweet's mentions
+		in.Timestamp = nowMillis()
+		s.store.View(func(tx *Tx) error {
+			in.TweetId = tx.freeID(in.Timestamp, s.currentOp()+1, tweetIDsBucket, notificationIDsBucket)
+			return nil
+		})
 
 		//Starting Prepare
 		index, _, ok := s.Start(in.String())
@@ -175,7 +272,7 @@ func (s *server) AddTweet(ctx context.Context, in *pb.AddTweetRequest) (*pb.AddT
 		//Majority of backups successfully performed the operation
 		if count >= len(s.peers)/2 {
 			fmt.Printf("Debug: Tweet '%s' successfuly added to the Majority servers {Replication achieved} \n",in.TweetText)
-			s.commitIndex = index
+			s.setCommitIndex(index)
 
 		} else {
 			//RPC to majority servers failed
@@ -185,30 +282,214 @@ func (s *server) AddTweet(ctx context.Context, in *pb.AddTweetRequest) (*pb.AddT
 	}
 
 
-	user, ok := userdata[in.Username]
-	if !ok {
+	//Add new tweet to the user's tweets
+	newTweet := tweet{ID: in.TweetId, Text: in.TweetText, Timestamp: in.Timestamp, ReplyTo: in.ReplyTo, Media: protoToMedia(in.Media),
+		Poll: newPoll(in.PollOptions, in.Timestamp, in.PollDuration)}
+	err := s.store.Update(func(tx *Tx) error {
+		var err error
+		if newTweet.ReplyTo != 0 {
+			err = tx.Reply(in.Username, newTweet)
+		} else {
+			err = tx.AddTweet(in.Username, newTweet)
+		}
+		if err != nil || in.ScheduledId == 0 {
+			return err
+		}
+		//the published tweet leaves the queue
+		return tx.deleteScheduled(in.Username, in.ScheduledId)
+	})
+	if err == errNoSuchUser {
 		debugPrint("Debug: No such user")
 		return &pb.AddTweetReply{Status: false}, errors.New("No such User")
+	} else if err != nil {
+		fmt.Printf("Error: Could not add tweet for %s: %s \n", in.Username, err)
+		return &pb.AddTweetReply{Status: false}, err
 	}
-	//Add new tweet and update in the Map
-	newTweet := tweet{text: in.TweetText}
-	user.tweets = append(user.tweets, newTweet)
-	userdata[in.Username] = user
 	fmt.Printf("Debug: Successfully added tweet '%s' for %s \n",in.TweetText,in.Username)
-	return &pb.AddTweetReply{Status: true}, nil
+	return &pb.AddTweetReply{Status: true, TweetId: in.TweetId}, nil
+}
+
+func (s *server) DeleteTweet(ctx context.Context, in *pb.DeleteTweetRequest) (*pb.DeleteTweetReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Delete Tweet operation, server is recovering")
+		return &pb.DeleteTweetReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Only the author may delete the tweet, an operation which fails everywhere is not logged
+		err := s.store.View(func(tx *Tx) error {
+			_, err := tx.OwnTweet(in.Username, in.TweetId)
+			return err
+		})
+		if err != nil {
+			return &pb.DeleteTweetReply{Status: false}, err
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Delete Tweet operation")
+			return &pb.DeleteTweetReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Delete Tweet RPC calls to all the backup servers
+				_, err := rpccaller.DeleteTweet(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Tweet %d deleted from Majority servers {Replication achieved} \n", in.TweetId)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Deleting tweet on all servers failed, tweet deleted only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.DeleteTweet(in.Username, in.TweetId)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Could not delete tweet %d of %s: %s \n", in.TweetId, in.Username, err)
+		return &pb.DeleteTweetReply{Status: false}, err
+	}
+	fmt.Printf("Debug: Successfully deleted tweet %d of %s \n", in.TweetId, in.Username)
+	return &pb.DeleteTweetReply{Status: true}, nil
+}
+
+func (s *server) EditTweet(ctx context.Context, in *pb.EditTweetRequest) (*pb.EditTweetReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Edit Tweet operation, server is recovering")
+		return &pb.EditTweetReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Only the author may edit the tweet and a retweet has no text, an operation which fails everywhere is not logged
+		err := s.store.View(func(tx *Tx) error {
+			t, err := tx.OwnTweet(in.Username, in.TweetId)
+			if err != nil {
+				return err
+			}
+			if t.RetweetOf != 0 {
+				return errRetweetEdit
+			}
+			return nil
+		})
+		if err != nil {
+			return &pb.EditTweetReply{Status: false}, err
+		}
+
+		//The time of the edit is fixed before it is logged, so every server keeps the same history
+		in.Timestamp = nowMillis()
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Edit Tweet operation")
+			return &pb.EditTweetReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Edit Tweet RPC calls to all the backup servers
+				_, err := rpccaller.EditTweet(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Tweet %d edited on Majority servers {Replication achieved} \n", in.TweetId)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Editing tweet on all servers failed, tweet edited only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.EditTweet(in.Username, in.TweetId, in.TweetText, in.Timestamp)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Could not edit tweet %d of %s: %s \n", in.TweetId, in.Username, err)
+		return &pb.EditTweetReply{Status: false}, err
+	}
+	fmt.Printf("Debug: Successfully edited tweet %d of %s \n", in.TweetId, in.Username)
+	return &pb.EditTweetReply{Status: true}, nil
 }
 
 func (s *server) OwnTweets(ctx context.Context, in *pb.OwnTweetsRequest) (*pb.OwnTweetsReply, error) {
-	user, ok := userdata[in.Username]
-	if (!ok) {
+	response := pb.OwnTweetsReply{}
+	viewer := in.Viewer
+	if viewer == "" {
+		viewer = in.Username
+	}
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.User(in.Username); !ok {
+			return errNoSuchUser
+		}
+		//Only approved followers see the tweets of a protected account
+		if tx.Blocked(viewer, in.Username) {
+			return errBlocked
+		}
+		if !tx.CanView(viewer, in.Username) {
+			return errProtected
+		}
+		return tx.ForEachTweet(in.Username, func(i tweet) error {
+			//other users do not see the user's retweets of tweets hidden from them
+			if viewer != in.Username && tx.HidesTweet(viewer, i) {
+				return nil
+			}
+			response.TweetList = append(response.TweetList, tweetToProto(i))
+			return nil
+		})
+	})
+	if err == errBlocked || err == errProtected {
+		return nil, err
+	}
+	if err != nil {
 		debugPrint("Debug: No such user")
 		return nil, errors.New("no such user")
 	}
-	response := pb.OwnTweetsReply{}
-	for _, i := range user.tweets {
-		tweetToAdd := pb.Tweet{Text: i.text}
-		response.TweetList = append(response.TweetList, &tweetToAdd)
-	}
+	s.decorateTweets(viewer, response.TweetList)
 	//debugPrint("Debug: your tweets")
 	//fmt.Println(response)
 	return &response, nil
@@ -216,7 +497,11 @@ func (s *server) OwnTweets(ctx context.Context, in *pb.OwnTweetsRequest) (*pb.Ow
 
 func (s *server) UserExists(ctx context.Context, in *pb.UserExistsRequest) (*pb.UserExistsReply, error) {
 	username := in.Username
-	_, ok := userdata[username]
+	var ok bool
+	s.store.View(func(tx *Tx) error {
+		_, ok = tx.ActiveUser(username)
+		return nil
+	})
 	if !ok {
 		debugPrint("Debug: No such user")
 		return &pb.UserExistsReply{Status: false}, errors.New("no such user exists")
@@ -227,9 +512,36 @@ func (s *server) UserExists(ctx context.Context, in *pb.UserExistsRequest) (*pb.
 
 func (s *server) DeleteUser(ctx context.Context, in *pb.Credentials) (*pb.DeleteReply, error) {
 
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Delete operation, server is recovering")
+		return &pb.DeleteReply{DeleteStatus: false}, errors.New("server is recovering")
+	}
+
 	// Will be Broadcasted to all the other servers
 	println(in.String())
 	if in.Broadcast == true {
+		//The operation is applied locally after it was sent to the backups, so the lock is held until we return
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		var user User
+		var exists bool
+		s.store.View(func(tx *Tx) error {
+			user, exists = tx.User(in.Uname)
+			return nil
+		})
+		if !exists {
+			return &pb.DeleteReply{DeleteStatus: false}, errNoSuchUser
+		}
+		//The primary decides whether the account is kept for the grace period and fixes the time of the deletion
+		in.Timestamp = nowMillis()
+		if *deleteGrace == 0 {
+			in.Purge = true
+		}
+		if !in.Purge && user.DeletedAt != 0 {
+			return &pb.DeleteReply{DeleteStatus: false}, errAccountDeleted
+		}
 
 		//Starting Prepare
 		index, _, ok := s.Start(in.String())
@@ -261,7 +573,7 @@ func (s *server) DeleteUser(ctx context.Context, in *pb.Credentials) (*pb.Delete
 		//Majority of backups successfully performed the operation
 		if count >= len(s.peers)/2 {
 			fmt.Printf("Debug: User %s deleted from Majority servers \n",in.Uname)
-			s.commitIndex = index
+			s.setCommitIndex(index)
 
 			// Master itself performing the operation
 			//debugPrint("Deleting User: " + in.Uname + "Account")
@@ -276,17 +588,126 @@ func (s *server) DeleteUser(ctx context.Context, in *pb.Credentials) (*pb.Delete
 	}
 
 	//debugPrint("Deleting User: " + in.Uname + "'s Account")
-	delete(userdata, in.Uname)
+	err := s.store.Update(func(tx *Tx) error {
+		if in.Purge {
+			return tx.PurgeUser(in.Uname)
+		}
+		return tx.DeactivateUser(in.Uname, in.Timestamp)
+	})
+	if err != nil {
+		fmt.Printf("Error: Could not delete user %s: %s \n", in.Uname, err)
+		return &pb.DeleteReply{DeleteStatus: false}, err
+	}
 	debugPrint("Debug: Successfully deleted user "+in.Uname)
 	return &pb.DeleteReply{DeleteStatus: true}, nil
 
 }
 
+func (s *server) RestoreUser(ctx context.Context, in *pb.Credentials) (*pb.RestoreReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Restore operation, server is recovering")
+		return &pb.RestoreReply{RestoreStatus: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Only a deleted account which was not purged yet can be restored, and only with its password
+		var user User
+		var ok bool
+		s.store.View(func(tx *Tx) error {
+			user, ok = tx.User(in.Uname)
+			return nil
+		})
+		if !ok || user.DeletedAt == 0 {
+			return &pb.RestoreReply{RestoreStatus: false}, errors.New("no deleted account to restore")
+		}
+		if user.Password != in.Pwd {
+			return &pb.RestoreReply{RestoreStatus: false}, errors.New("wrong password")
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Debug: Discarding last Restore operation")
+			return &pb.RestoreReply{RestoreStatus: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Restore User RPC calls to all the backup servers
+				_, err := rpccaller.RestoreUser(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: User %s restored on Majority servers \n", in.Uname)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: User Restore failed, User restored only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.DeactivateUser(in.Uname, 0)
+	})
+	if err != nil {
+		fmt.Printf("Error: Could not restore user %s: %s \n", in.Uname, err)
+		return &pb.RestoreReply{RestoreStatus: false}, err
+	}
+	debugPrint("Debug: Successfully restored user " + in.Uname)
+	return &pb.RestoreReply{RestoreStatus: true}, nil
+}
+
 func (s *server) FollowUser(ctx context.Context, in *pb.FollowUserRequest) (*pb.FollowUserResponse, error) {
 
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Follow User operation, server is recovering")
+		return &pb.FollowUserResponse{FollowStatus: false}, errors.New("server is recovering")
+	}
+
 	//println(in.String())
 	// Will be Broadcasted to all the other servers
 	if in.Broadcast == true {
+		//The operation is applied locally after it was sent to the backups, so the lock is held until we return
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Following a user who blocked us, or whom we blocked, fails everywhere and is not logged
+		var blocked bool
+		s.store.View(func(tx *Tx) error {
+			blocked = tx.Blocked(in.SelfUsername, in.ToFollowUsername)
+			return nil
+		})
+		if blocked {
+			return &pb.FollowUserResponse{FollowStatus: false}, errBlocked
+		}
+
+		//The notification sent to the followed user gets its ID and time before the operation is logged
+		in.Timestamp = nowMillis()
+		s.store.View(func(tx *Tx) error {
+			in.NotificationId = tx.freeID(in.Timestamp, s.currentOp()+1, notificationIDsBucket)
+			return nil
+		})
 
 		//Starting Prepare
 		index, _, ok := s.Start(in.String())
@@ -318,7 +739,7 @@ func (s *server) FollowUser(ctx context.Context, in *pb.FollowUserRequest) (*pb.
 		//Majority of backups successfully performed the operation
 		if count >= len(s.peers)/2 {
 			fmt.Printf("Debug: User %s followed User %s  replicated on Majority servers {Replication achieved} \n",in.SelfUsername,in.ToFollowUsername)
-			s.commitIndex = index
+			s.setCommitIndex(index)
 
 		} else {
 			//RPC to majority servers failed
@@ -328,63 +749,173 @@ func (s *server) FollowUser(ctx context.Context, in *pb.FollowUserRequest) (*pb.
 	}
 
 	//debugPrint("User: " + in.SelfUsername + " has requested to follow: " + in.ToFollowUsername)
-	//Getting user from user data map and adding the new user to be followed
-	user, ok := userdata[in.SelfUsername]
-	if !ok {
-		return &pb.FollowUserResponse{FollowStatus: false}, errors.New("Debug: Selfuser does not exist")
+	//Checking both users exist and adding the new user to be followed
+	errNoSelfUser := errors.New("Debug: Selfuser does not exist")
+	errNoToFollowUser := errors.New("Debug: ToFollow user does not exist")
+	pending := false
+	err := s.store.Update(func(tx *Tx) error {
+		if _, ok := tx.ActiveUser(in.SelfUsername); !ok {
+			return errNoSelfUser
+		}
+		toFollow, ok2 := tx.ActiveUser(in.ToFollowUsername)
+		if !ok2 {
+			return errNoToFollowUser
+		}
+		if tx.Blocked(in.SelfUsername, in.ToFollowUsername) {
+			return errBlocked
+		}
+		if tx.IsFollowing(in.SelfUsername, in.ToFollowUsername) {
+			return nil
+		}
+		//A protected account approves its followers, the follow waits as a request until then
+		if toFollow.Protected && in.SelfUsername != in.ToFollowUsername {
+			pending = true
+			n := notification{ID: in.NotificationId, Kind: followRequestNotification, Actor: in.SelfUsername, Timestamp: in.Timestamp}
+			return tx.RequestFollow(in.SelfUsername, in.ToFollowUsername, n)
+		}
+		if err := tx.Follow(in.SelfUsername, in.ToFollowUsername); err != nil {
+			return err
+		}
+		n := notification{ID: in.NotificationId, Kind: followNotification, Actor: in.SelfUsername, Timestamp: in.Timestamp}
+		return tx.Notify(in.ToFollowUsername, n)
+	})
+	if err != nil {
+		return &pb.FollowUserResponse{FollowStatus: false}, err
 	}
-	_, ok2 := userdata[in.ToFollowUsername]
-	if !ok2 {
-		return &pb.FollowUserResponse{FollowStatus: false}, errors.New("Debug: ToFollow user does not exist")
+	if pending {
+		fmt.Printf("Debug: %s asked to follow protected user %s \n", in.SelfUsername, in.ToFollowUsername)
+		return &pb.FollowUserResponse{FollowStatus: true, Pending: true}, nil
 	}
-	//fmt.Println("value of ok2", ok2, in.ToFollowUsername)
-	user.follows[in.ToFollowUsername] = true
 	fmt.Printf("Debug: %s follows user %s successfully mapped",in.SelfUsername,in.ToFollowUsername)
 	return &pb.FollowUserResponse{FollowStatus: true}, nil
 
 }
 
+func (s *server) UnfollowUser(ctx context.Context, in *pb.UnfollowUserRequest) (*pb.UnfollowUserResponse, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Unfollow User operation, server is recovering")
+		return &pb.UnfollowUserResponse{UnfollowStatus: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//An operation which fails everywhere is not logged. Unfollowing a protected account which did not
+		//answer the follow request yet takes the request back
+		var following bool
+		s.store.View(func(tx *Tx) error {
+			following = tx.IsFollowing(in.SelfUsername, in.ToUnfollowUsername) || tx.HasRequested(in.SelfUsername, in.ToUnfollowUsername)
+			return nil
+		})
+		if !following {
+			return &pb.UnfollowUserResponse{UnfollowStatus: false}, errNotFollowing
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Debug: Discarding last Unfollow User operation")
+			return &pb.UnfollowUserResponse{UnfollowStatus: false}, errors.New("Backend Replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Unfollow User RPC calls to all the backup servers
+				_, err := rpccaller.UnfollowUser(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: User %s unfollowed User %s replicated on Majority servers {Replication achieved} \n", in.SelfUsername, in.ToUnfollowUsername)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Unfollowing user on all servers failed, unfollowed only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		if tx.HasRequested(in.SelfUsername, in.ToUnfollowUsername) {
+			return tx.deleteFollowRequest(in.SelfUsername, in.ToUnfollowUsername)
+		}
+		return tx.Unfollow(in.SelfUsername, in.ToUnfollowUsername)
+	})
+	if err != nil {
+		return &pb.UnfollowUserResponse{UnfollowStatus: false}, err
+	}
+	fmt.Printf("Debug: %s unfollowed user %s successfully \n", in.SelfUsername, in.ToUnfollowUsername)
+	return &pb.UnfollowUserResponse{UnfollowStatus: true}, nil
+}
+
 func (s *server) UsersToFollow(ctx context.Context, in *pb.UsersToFollowRequest) (*pb.UsersToFollowResponse, error) {
 	response := &pb.UsersToFollowResponse{}
-	//Get the user from our Map
-	user, isUserPresent := userdata[in.Username]
-	//fmt.Println("Self Username: ", user.username)
-	if isUserPresent {
-		for eachUser := range userdata {
-			_, ok := user.follows[eachUser]
-			//fmt.Println("Each User: ", eachUser)
-			if ok == false && eachUser != user.username {
+	err := s.store.View(func(tx *Tx) error {
+		//Get the user from our store
+		if _, isUserPresent := tx.User(in.Username); !isUserPresent {
+			return errNoSuchUser
+		}
+		return tx.ForEachUser(func(eachUser User) error {
+			ok := tx.IsFollowing(in.Username, eachUser.Username) || tx.HasRequested(in.Username, eachUser.Username)
+			//Blocked and muted users are not suggested
+			if ok == false && eachUser.Username != in.Username && eachUser.DeletedAt == 0 && !tx.Hides(in.Username, eachUser.Username) {
 				//Preparing a list of all the users to follow list
-				response.UsersToFollowList = append(response.UsersToFollowList, &pb.User{Username: eachUser})
+				response.UsersToFollowList = append(response.UsersToFollowList, &pb.User{Username: eachUser.Username, Protected: eachUser.Protected})
 			}
-		}
-		return response, nil
-	} else {
+			return nil
+		})
+	})
+	if err != nil {
 		return nil, errors.New("User does not exist!")
 	}
+	return response, nil
 }
 
 func (s *server) GetFriendsTweets(ctx context.Context, in *pb.GetFriendsTweetsRequest) (*pb.GetFriendsTweetsResponse, error) {
 	response := &pb.GetFriendsTweetsResponse{}
 
-	//Get the user from our Map
-	user, isUserPresent := userdata[in.Username]
-	if isUserPresent {
-		for eachFollowedUser := range user.follows {
-			//Iterate through all the Followed Users
-			eachFollowedUserData := userdata[eachFollowedUser]
+	s.store.View(func(tx *Tx) error {
+		//Iterate through all the Followed Users
+		return tx.ForEachFollow(in.Username, func(eachFollowedUser string) error {
+			//Deleted accounts are hidden until they are restored
+			if _, ok := tx.ActiveUser(eachFollowedUser); !ok {
+				return nil
+			}
+			//Muted users are still followed, their tweets are not shown
+			if tx.Hides(in.Username, eachFollowedUser) {
+				return nil
+			}
 			userAllTweets := &pb.UsersAllTweets{}
 			userAllTweets.Username = &pb.User{Username: eachFollowedUser}
-			//println(eachFollowedUser)
 			//Append all the tweets ap per the User
-			for _, eachUserTweet := range eachFollowedUserData.tweets {
-				//println(eachUserTweet.text)
-				userAllTweets.Tweets = append(userAllTweets.Tweets, &pb.Tweet{Text: eachUserTweet.text})
-			}
+			tx.ForEachTweet(eachFollowedUser, func(eachUserTweet tweet) error {
+				if tx.HidesTweet(in.Username, eachUserTweet) {
+					return nil
+				}
+				userAllTweets.Tweets = append(userAllTweets.Tweets, tweetToProto(eachUserTweet))
+				return nil
+			})
 			//Append all of current Followed users data into the response
 			response.FriendsTweets = append(response.FriendsTweets, userAllTweets)
-		}
-	}
+			return nil
+		})
+	})
 
 	println(response.FriendsTweets)
 	return response, nil
@@ -392,16 +923,99 @@ func (s *server) GetFriendsTweets(ctx context.Context, in *pb.GetFriendsTweetsRe
 
 //This function is used by the FE server to talk to any server and get a response of who the primary is
 func (s *server) WhoIsPrimary(ctx context.Context, in *pb.WhoisPrimaryRequest) (*pb.WhoIsPrimaryResponse, error) {
-	primaryIndex := GetPrimary(s.currentView, len(s.peers))
+	view, _ := s.viewStatus()
+	primaryIndex := GetPrimary(view, len(s.peers))
 	if primaryIndex > -1 && primaryIndex < len(s.peers) {
 		return &pb.WhoIsPrimaryResponse{Index: int32(primaryIndex)}, nil
 	}
 	return &pb.WhoIsPrimaryResponse{Index: -1}, errors.New("Debug: Index of primary out of bounds")
 }
 
-//used to rpc and check if connection is alive
+//purgeDeletedAccounts runs in the background on every server, the primary purges accounts whose grace period ended
+func (srv *server) purgeDeletedAccounts() {
+	for {
+		time.Sleep(purgeInterval)
+		view, status := srv.viewStatus()
+		if status != NORMAL || GetPrimary(view, len(srv.peers)) != srv.me {
+			continue
+		}
+		deadline := nowMillis() - int64(*deleteGrace/time.Millisecond)
+		var expired []string
+		srv.store.View(func(tx *Tx) error {
+			return tx.ForEachUser(func(user User) error {
+				if user.DeletedAt != 0 && user.DeletedAt <= deadline {
+					expired = append(expired, user.Username)
+				}
+				return nil
+			})
+		})
+		//Purging is a replicated operation like any other deletion
+		for _, username := range expired {
+			fmt.Printf("Debug: Grace period of deleted account %s ended, purging it \n", username)
+			srv.DeleteUser(context.Background(), &pb.Credentials{Uname: username, Broadcast: true, Purge: true})
+		}
+	}
+}
+
+//used to rpc and check if connection is alive. A recovering server can't serve requests, so it reports itself as not alive
 func (s *server) HeartBeat(ctx context.Context, in *pb.HeartBeatRequest) (*pb.HeartBeatResponse, error) {
-	return &pb.HeartBeatResponse{IsAlive: true, CurrentView: int32(s.currentView)}, nil
+	view, status := s.viewStatus()
+	return &pb.HeartBeatResponse{IsAlive: status != RECOVERING, CurrentView: int32(view)}, nil
+}
+
+//viewStatus returns the current view and status. Handlers read them concurrently with the replication protocol
+func (srv *server) viewStatus() (view int, status int) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	return srv.currentView, srv.status
+}
+
+//currentOp returns the number of the latest operation in the log
+func (srv *server) currentOp() int {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	return srv.opNo
+}
+
+//setCommitIndex is called by the primary once an operation was applied on a majority of servers
+func (srv *server) setCommitIndex(index int) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if index > srv.commitIndex {
+		srv.commitIndex = index
+	}
+}
+
+//applyInterceptor serializes the operations a backup applies for the primary with anti-entropy repairs and records
+//the latest applied operation. An operation reaches a backup after the Prepare logging it, so the op number when it
+//arrives is the one it was logged at. Login is the only RPC with a broadcast flag which does not change state
+func (srv *server) applyInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	op, ok := req.(interface {
+		GetBroadcast() bool
+	})
+	if !ok || op.GetBroadcast() || strings.HasSuffix(info.FullMethod, "/Login") {
+		return handler(ctx, req)
+	}
+	srv.applyMu.Lock()
+	defer srv.applyMu.Unlock()
+	index := srv.currentOp()
+	reply, err := handler(ctx, req)
+	srv.mu.Lock()
+	srv.applied = index
+	srv.mu.Unlock()
+	return reply, err
+}
+
+//appliedThrough reports whether this backup applied every operation up to op and logged no later one
+func (srv *server) appliedThrough(op int) bool {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	return srv.opNo == op && srv.applied == op
+}
+
+//nowMillis returns the current time in unix milliseconds, the unit of all timestamps in the application state
+func nowMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
 }
 
 //internal function call
@@ -420,6 +1034,11 @@ func (srv *server) Prepare(ctx context.Context, args *pb.PrepareArgs) (reply *pb
 		return
 	}
 
+	//Do not acknowledge anything until recovery has completed
+	if srv.status == RECOVERING {
+		return
+	}
+
 	if int(args.Index) <= srv.commitIndex {
 		return
 	}
@@ -427,62 +1046,40 @@ func (srv *server) Prepare(ctx context.Context, args *pb.PrepareArgs) (reply *pb
 		srv.commitIndex = int(args.PrimaryCommit)
 	}
 
-	if int(args.Index) != srv.opNo+1 || int(args.View) > srv.currentView {
+	//The entry's hash is chained to the hash of the previous entry, a mismatch means our log diverged from the primary's
+	diverged := int(args.Index) == srv.opNo+1 && hashEntry(srv.lastHash(), args.Entry) != args.Hash
+	if diverged {
+		fmt.Printf("Debug: Log diverged from the primary's before op %d \n", args.Index)
+	}
+
+	if int(args.Index) != srv.opNo+1 || int(args.View) > srv.currentView || diverged {
 		fmt.Println("Debug:~~~~~~~~~~~~~~Server needs to recover~~~~~~~~~~~~~")
 		//log.Fatal("Debug: Server needs to recover")
 		srv.status = RECOVERING
 		PrimaryIndex := GetPrimary(int(args.View), len(srv.peers))
+		nonce := newNonce()
 		RecoveryInArgs := pb.RecoveryArgs{
 			View:   args.View,
 			Server: int32(srv.me),
+			Nonce:  nonce,
 		}
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
 		RecoveryOutArgs, err := srv.peerRPC[PrimaryIndex].Recovery(ctx, &RecoveryInArgs)
 
-		if err == nil {
-			if RecoveryOutArgs.Success {
-				srv.log = RecoveryOutArgs.Entries
-				srv.commitIndex = int(RecoveryOutArgs.PrimaryCommit)
-				srv.currentView = int(RecoveryOutArgs.View)
-
-				//StartRestoring Data
-				userdata = make(map[string]User)
-				for _, recoveredUser := range RecoveryOutArgs.Data {
-					//recover user credentials
-					userToRecover := User{username: recoveredUser.Username, password: recoveredUser.Password}
-					userToRecover.follows = make(map[string]bool)
-					//recover tweets for user
-					for _, tweetToRecover := range recoveredUser.TweetList {
-						recreatedTweet := tweet{text: tweetToRecover.Text}
-						userToRecover.tweets = append(userToRecover.tweets, recreatedTweet)
-					}
-					//recover users followlist
-					for _, followerToRecover := range recoveredUser.Follows {
-						userToRecover.follows[followerToRecover] = true
-					}
-					//add user to user data
-					userdata[userToRecover.username] = userToRecover
-				}
-
-				srv.status = NORMAL
-				srv.opNo = len(srv.log) - 1
-				//srv.commitIndex=int(args.PrimaryCommit)
-				reply.Success = true
-				//fmt.Println(userdata)			Todo: Discuss if this should be logged
-				fmt.Println("Debug: Recovery Completed")
-				return reply, nil
-			} else {
-				return reply, errors.New("Error: Error while recovering")
-
-			}
-		} else {
-			return reply, errors.New("Error: Error while recovering")
+		if err == nil && RecoveryOutArgs.Success && RecoveryOutArgs.Nonce == nonce {
+			srv.installRecovery(RecoveryOutArgs)
+			reply.Success = true
+			fmt.Println("Debug: Recovery Completed")
+			return reply, nil
 		}
+		//The primary could not bring us up to date, fall back to the full recovery protocol
+		go srv.startRecovery()
+		return reply, errors.New("Error: Error while recovering")
 	}
 	//srv.commitIndex=args.PrimaryCommit
 	if int(args.Index) == len(srv.log) {
-		srv.log = append(srv.log, args.Entry)
+		srv.log = append(srv.log, &pb.LogEntry{Command: args.Entry, Hash: args.Hash})
 		srv.opNo = srv.opNo + 1
 		srv.commitIndex = int(args.PrimaryCommit)
 		reply.Success = true
@@ -494,35 +1091,42 @@ func (srv *server) Prepare(ctx context.Context, args *pb.PrepareArgs) (reply *pb
 
 //Start calls prepare and returns index to commit on. In this case with >1/2 prepare's start does not immediately write the commit index.
 //The commit index is updated after > 1/2 Prepare+RPC
+//Callers hold opMu, so operations reach the backups in log order
 func (srv *server) Start(command string) (index int, view int, ok bool) {
 	srv.mu.Lock()
-	defer srv.mu.Unlock()
+	view = srv.currentView
 	// do not process command if status is not NORMAL
 	// and if i am not the primary in the current view
 	if srv.status != NORMAL {
+		srv.mu.Unlock()
 		debugPrint("Debug: Request can't be processed as the Server is not in NORMAL mode")
-		return -1, srv.currentView, false
+		return -1, view, false
 	} else if GetPrimary(srv.currentView, len(srv.peers)) != srv.me {
+		srv.mu.Unlock()
 		//Check if you're the Primary
 		debugPrint("Debug: Illegal request made to a Non-primary server")
-		return -1, srv.currentView, false
+		return -1, view, false
 	}
 
 	//In case of failure, the command is still added to the log so we tell backup the new index
-	srv.log = append(srv.log, command)
+	entry := &pb.LogEntry{Command: command, Hash: hashEntry(srv.lastHash(), command)}
+	srv.log = append(srv.log, entry)
 	srv.opNo = srv.opNo + 1
+	inArgs := &pb.PrepareArgs{
+		View:          int32(srv.currentView),
+		PrimaryCommit: int32(srv.commitIndex),
+		Index:         int32(srv.opNo),
+		Entry:         command,
+		Hash:          entry.Hash,
+	}
+	//mu is not held during the Prepare rpcs: a backup which has fallen behind calls Recovery on us to catch up
+	srv.mu.Unlock()
 	count := 0
 
 	//Calling all backups
 	for i, rpcEndPoint := range srv.peerRPC {
 		if i != srv.me {
 			pointer := i
-			inArgs := &pb.PrepareArgs{
-				View:          int32(srv.currentView),
-				PrimaryCommit: int32(srv.commitIndex),
-				Index:         int32(srv.opNo),
-				Entry:         command,
-			}
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
 			outArgs, err := rpcEndPoint.Prepare(ctx, inArgs)
@@ -548,7 +1152,7 @@ func (srv *server) Start(command string) (index int, view int, ok bool) {
 	//Check if majority calls have returned, consider Primary as committed
 	if count >= length/2 {
 		ok = true
-		index = srv.opNo
+		index = int(inArgs.Index)
 	} else {
 		index = -1
 		ok = false
@@ -559,36 +1163,528 @@ func (srv *server) Start(command string) (index int, view int, ok bool) {
 
 func (srv *server) Recovery(ctx context.Context, args *pb.RecoveryArgs) (reply *pb.RecoveryReply, err error) {
 
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
 	reply = &pb.RecoveryReply{}
 	reply.View = int32(srv.currentView)
+	reply.Nonce = args.Nonce
+
+	//Only a server in NORMAL status can help another server recover
+	if srv.status != NORMAL {
+		reply.Success = false
+		return reply, nil
+	}
+	reply.Success = true
+
+	//Backups only tell the recovering server their view, the primary also sends its log and data
+	if GetPrimary(srv.currentView, len(srv.peers)) != srv.me {
+		return reply, nil
+	}
 	reply.Entries = srv.log
 	reply.PrimaryCommit = int32(srv.commitIndex)
-	reply.Success = true
 
 	//Start Initializing data
-	for _, value := range userdata {
-		//add users credentials to userobject
-		userToAdd := &pb.UserData{Username: value.username, Password: value.password}
+	srv.store.View(func(tx *Tx) error {
+		return tx.ForEachUser(func(value User) error {
+			reply.Data = append(reply.Data, userToData(tx, srv.blobs, value))
+			return nil
+		})
+	})
+
+	return reply, nil
+
+	return
+}
+
+//userToData converts a user into the message used to transfer state between servers, together with the blobs
+//it references
+func userToData(tx *Tx, blobs *blobStore, value User) *pb.UserData {
+	//add users credentials to userobject
+	userToAdd := &pb.UserData{Username: value.Username, Password: value.Password, DeletedAt: value.DeletedAt, OpenDMs: value.OpenDMs,
+		Protected: value.Protected}
+
+	//add the user's profile and avatar image to userobject
+	userToAdd.DisplayName = value.DisplayName
+	userToAdd.Bio = value.Bio
+	userToAdd.Location = value.Location
+	userToAdd.Website = value.Website
+	userToAdd.Avatar = value.Avatar
+	if value.Avatar != "" {
+		data, err := blobs.Get(value.Avatar)
+		if err != nil {
+			fmt.Printf("Error: Avatar of %s is missing: %s \n", value.Username, err)
+		}
+		userToAdd.AvatarData = data
+	}
+
+	//add users tweets to userobject
+	tx.ForEachTweet(value.Username, func(userTweet tweet) error {
+		userToAdd.TweetList = append(userToAdd.TweetList, tweetToProto(userTweet))
+		return nil
+	})
+	//add the images attached to the user's tweets to userobject
+	for _, t := range userToAdd.TweetList {
+		for _, m := range t.Media {
+			for _, id := range []string{m.Id, m.Thumbnail} {
+				data, err := blobs.Get(id)
+				if err != nil {
+					fmt.Printf("Error: Image %s of %s is missing: %s \n", id, value.Username, err)
+					continue
+				}
+				userToAdd.Blobs = append(userToAdd.Blobs, &pb.Blob{Id: id, Data: data})
+			}
+		}
+	}
+
+	//add users followlist to userobject
+	tx.ForEachFollow(value.Username, func(userFollows string) error {
+		userToAdd.Follows = append(userToAdd.Follows, userFollows)
+		return nil
+	})
+
+	//add the tweets the user liked to userobject
+	userToAdd.Likes = tx.likedIDs(value.Username)
+
+	//add the user's queue of scheduled tweets to userobject
+	tx.ForEachScheduled(value.Username, func(st scheduledTweet) error {
+		userToAdd.Scheduled = append(userToAdd.Scheduled, scheduledToProto(st))
+		return nil
+	})
+
+	//add the lists the user owns with their members to userobject
+	tx.ForEachList(value.Username, func(l userList) error {
+		userToAdd.Lists = append(userToAdd.Lists, tx.listToProto(l, true))
+		return nil
+	})
+
+	//add the user's votes in polls to userobject
+	for _, v := range tx.votes(value.Username) {
+		userToAdd.Votes = append(userToAdd.Votes, &pb.PollVote{TweetId: v.TweetID, Option: int32(v.Option)})
+	}
+
+	//add the user's notifications to userobject
+	for _, n := range tx.notifications(value.Username) {
+		userToAdd.Notifications = append(userToAdd.Notifications, notificationToProto(n))
+	}
+	userToAdd.NotificationsRead = tx.NotificationsRead(value.Username)
+
+	//add the user's direct message mailbox to userobject
+	tx.ForEachConversation(value.Username, func(c dmConversation) error {
+		userToAdd.Conversations = append(userToAdd.Conversations, conversationToProto(c))
+		return nil
+	})
+	tx.ForEachMessage(value.Username, 0, -1, func(m directMessage) error {
+		userToAdd.Messages = append(userToAdd.Messages, messageToProto(m))
+		return nil
+	})
+
+	//add the protected accounts the user asked to follow to userobject
+	tx.ForEachRequested(value.Username, func(account string) error {
+		userToAdd.FollowRequests = append(userToAdd.FollowRequests, account)
+		return nil
+	})
+
+	//add the users the user blocked and muted to userobject
+	tx.ForEachRelation(blockRelation, value.Username, func(target string) error {
+		userToAdd.Blocks = append(userToAdd.Blocks, target)
+		return nil
+	})
+	tx.ForEachRelation(muteRelation, value.Username, func(target string) error {
+		userToAdd.Mutes = append(userToAdd.Mutes, target)
+		return nil
+	})
+	return userToAdd
+}
+
+//tweetToProto converts a stored tweet into the message returned to clients and other servers
+func tweetToProto(t tweet) *pb.Tweet {
+	reply := &pb.Tweet{Id: t.ID, Text: t.Text, Timestamp: t.Timestamp, Author: t.Author, EditedAt: t.EditedAt, Likes: int32(t.Likes),
+		RetweetOf: t.RetweetOf, QuoteOf: t.QuoteOf, Retweets: int32(t.Retweets), Quotes: int32(t.Quotes),
+		ReplyTo: t.ReplyTo, Replies: int32(t.Replies), Mentions: t.Mentions,
+		Hashtags: t.Hashtags, Media: mediaToProto(t.Media), Poll: pollToProto(t.Poll)}
+	for _, edit := range t.History {
+		reply.History = append(reply.History, &pb.TweetEdit{Text: edit.Text, Timestamp: edit.Timestamp})
+	}
+	return reply
+}
+
+//protoToTweet converts a tweet sent by another server back into a stored tweet
+func protoToTweet(in *pb.Tweet) tweet {
+	t := tweet{ID: in.Id, Text: in.Text, Timestamp: in.Timestamp, Author: in.Author, EditedAt: in.EditedAt, Likes: int(in.Likes),
+		RetweetOf: in.RetweetOf, QuoteOf: in.QuoteOf, Retweets: int(in.Retweets), Quotes: int(in.Quotes),
+		ReplyTo: in.ReplyTo, Replies: int(in.Replies), Mentions: in.Mentions,
+		Hashtags: in.Hashtags, Media: protoToMedia(in.Media), Poll: protoToPoll(in.Poll)}
+	for _, edit := range in.History {
+		t.History = append(t.History, tweetEdit{Text: edit.Text, Timestamp: edit.Timestamp})
+	}
+	return t
+}
+
+//putUserData stores a user sent by another server, replacing any local copy of it. Timelines are not
+//updated, callers rebuild them once all users are stored
+func putUserData(tx *Tx, blobs *blobStore, recoveredUser *pb.UserData) error {
+	if err := tx.DeleteUser(recoveredUser.Username); err != nil {
+		return err
+	}
+	//recover the avatar image and the images attached to tweets, blobs are stored outside the transaction
+	if len(recoveredUser.AvatarData) != 0 {
+		if _, err := blobs.Put(recoveredUser.AvatarData); err != nil {
+			return err
+		}
+	}
+	for _, blob := range recoveredUser.Blobs {
+		if _, err := blobs.Put(blob.Data); err != nil {
+			return err
+		}
+	}
+	//recover user credentials and profile
+	recoveredCredentials := User{Username: recoveredUser.Username, Password: recoveredUser.Password, DeletedAt: recoveredUser.DeletedAt,
+		OpenDMs: recoveredUser.OpenDMs, Protected: recoveredUser.Protected, DisplayName: recoveredUser.DisplayName,
+		Bio: recoveredUser.Bio, Location: recoveredUser.Location, Website: recoveredUser.Website, Avatar: recoveredUser.Avatar}
+	if err := tx.PutUser(recoveredCredentials); err != nil {
+		return err
+	}
+	//recover tweets for user
+	for _, tweetToRecover := range recoveredUser.TweetList {
+		if err := tx.PutTweet(recoveredUser.Username, protoToTweet(tweetToRecover)); err != nil {
+			return err
+		}
+	}
+	//recover users followlist
+	for _, followerToRecover := range recoveredUser.Follows {
+		if err := tx.putFollow(recoveredUser.Username, followerToRecover); err != nil {
+			return err
+		}
+	}
+	//recover the tweets the user liked, the like counts came with the tweets
+	for _, id := range recoveredUser.Likes {
+		if err := tx.putLike(recoveredUser.Username, id); err != nil {
+			return err
+		}
+	}
+	//recover the user's queue of scheduled tweets
+	for _, st := range recoveredUser.Scheduled {
+		if err := tx.putScheduled(recoveredUser.Username, protoToScheduled(st)); err != nil {
+			return err
+		}
+	}
+	//recover the lists the user owns with their members
+	for _, l := range recoveredUser.Lists {
+		if err := tx.putList(protoToList(l)); err != nil {
+			return err
+		}
+		for _, member := range l.Members {
+			if err := tx.putListMember(l.Id, member); err != nil {
+				return err
+			}
+		}
+	}
+	//recover the user's votes in polls, the vote counts came with the tweets
+	for _, v := range recoveredUser.Votes {
+		if err := tx.putVote(recoveredUser.Username, v.TweetId, int(v.Option)); err != nil {
+			return err
+		}
+	}
+	//recover the user's notifications
+	for _, n := range recoveredUser.Notifications {
+		if err := tx.putNotification(recoveredUser.Username, protoToNotification(n)); err != nil {
+			return err
+		}
+	}
+	if recoveredUser.NotificationsRead != 0 {
+		if err := tx.putNotificationsRead(recoveredUser.Username, recoveredUser.NotificationsRead); err != nil {
+			return err
+		}
+	}
+	//recover the user's direct message mailbox
+	for _, c := range recoveredUser.Conversations {
+		if err := tx.putConversation(recoveredUser.Username, protoToConversation(c)); err != nil {
+			return err
+		}
+	}
+	for _, m := range recoveredUser.Messages {
+		if err := tx.putMessage(recoveredUser.Username, protoToMessage(m)); err != nil {
+			return err
+		}
+	}
+	//recover the user's pending follow requests
+	for _, account := range recoveredUser.FollowRequests {
+		if err := tx.putFollowRequest(recoveredUser.Username, account); err != nil {
+			return err
+		}
+	}
+	//recover the users the user blocked and muted
+	for _, target := range recoveredUser.Blocks {
+		if err := tx.putRelation(blockRelation, recoveredUser.Username, target); err != nil {
+			return err
+		}
+	}
+	for _, target := range recoveredUser.Mutes {
+		if err := tx.putRelation(muteRelation, recoveredUser.Username, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//installRecovery replaces this server's log and user data with the state sent by the primary and rejoins the view
+func (srv *server) installRecovery(recovered *pb.RecoveryReply) {
+	srv.log = recovered.Entries
+	srv.commitIndex = int(recovered.PrimaryCommit)
+	srv.currentView = int(recovered.View)
+	srv.lastNormalView = srv.currentView
+
+	//StartRestoring Data
+	err := srv.store.Update(func(tx *Tx) error {
+		if err := tx.Reset(); err != nil {
+			return err
+		}
+		for _, recoveredUser := range recovered.Data {
+			if err := putUserData(tx, srv.blobs, recoveredUser); err != nil {
+				return err
+			}
+		}
+		return tx.RebuildTimelines()
+	})
+	if err != nil {
+		fmt.Printf("Error: Could not restore recovered data: %s \n", err)
+	}
+
+	srv.status = NORMAL
+	srv.opNo = len(srv.log) - 1
+	srv.applied = srv.opNo
+	srv.recoveryNonce = 0
+	srv.persistView()
+}
+
+//startRecovery runs the VR recovery protocol. The server stays in RECOVERING and refuses to take part in
+//replication until a majority of the other servers, including the primary of the latest view, answer its nonce.
+func (srv *server) startRecovery() {
+	srv.mu.Lock()
+	if srv.recoveryNonce != 0 {
+		//A recovery is already in progress
+		srv.mu.Unlock()
+		return
+	}
+	srv.status = RECOVERING
+	srv.mu.Unlock()
+
+	for {
+		nonce := newNonce()
+		srv.mu.Lock()
+		srv.recoveryNonce = nonce
+		args := &pb.RecoveryArgs{View: int32(srv.currentView), Server: int32(srv.me), Nonce: nonce}
+		srv.mu.Unlock()
+		fmt.Printf("Debug: Server %d is recovering, sending recovery requests with nonce %d \n", srv.me, nonce)
+
+		type recoveryResponse struct {
+			server int
+			reply  *pb.RecoveryReply
+		}
+		replyChan := make(chan recoveryResponse, len(srv.peers))
+		for i := 0; i < len(srv.peers); i++ {
+			if i == srv.me {
+				continue
+			}
+			go func(server int) {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				reply, err := srv.peerRPC[server].Recovery(ctx, args)
+				//Replies to an older recovery attempt are discarded
+				if err == nil && reply.Success && reply.Nonce == nonce {
+					replyChan <- recoveryResponse{server, reply}
+				} else {
+					replyChan <- recoveryResponse{server, nil}
+				}
+			}(i)
+		}
 
-		//add users tweets to userobject
-		for _, userTweet := range value.tweets {
-			tweetToAdd := &pb.Tweet{Text: userTweet.text}
-			userToAdd.TweetList = append(userToAdd.TweetList, tweetToAdd)
+		responses := make(map[int]*pb.RecoveryReply)
+		for n := 0; n < len(srv.peers)-1; n++ {
+			r := <-replyChan
+			if r.reply != nil {
+				responses[r.server] = r.reply
+			}
+		}
+		if srv.completeRecovery(nonce, responses) {
+			return
 		}
+		time.Sleep(time.Second)
+	}
+}
 
-		//add users followlist to userobject
-		for userFollows := range value.follows {
-			userToAdd.Follows = append(userToAdd.Follows, userFollows)
+//completeRecovery installs the primary's state once a majority including the primary of the latest view has answered
+func (srv *server) completeRecovery(nonce int64, responses map[int]*pb.RecoveryReply) bool {
+	majority := len(srv.peers)/2 + 1
+	if len(responses) < majority {
+		fmt.Printf("Debug: Only %d servers answered the recovery request, retrying \n", len(responses))
+		return false
+	}
+	latestView := -1
+	for _, r := range responses {
+		if int(r.View) > latestView {
+			latestView = int(r.View)
 		}
+	}
+	primary := GetPrimary(latestView, len(srv.peers))
+	primaryReply, ok := responses[primary]
+	if !ok || int(primaryReply.View) != latestView {
+		fmt.Printf("Debug: Primary %d of view %d has not answered the recovery request, retrying \n", primary, latestView)
+		return false
+	}
 
-		//Now finally after building the userobject append this to the recoveryReply
-		reply.Data = append(reply.Data, userToAdd)
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.recoveryNonce != nonce {
+		return false
+	}
+	srv.installRecovery(primaryReply)
+	fmt.Printf("Debug: Recovery Completed, rejoining view %d \n", srv.currentView)
+	return true
+}
 
+//newNonce returns a random non-zero nonce for a recovery attempt
+func newNonce() int64 {
+	for {
+		if nonce := rand.Int63(); nonce != 0 {
+			return nonce
+		}
 	}
+}
+
+//persistView writes the view numbers to stable storage, so that a restarted server knows it has to recover
+func (srv *server) persistView() {
+	data := fmt.Sprintf("%d %d", srv.currentView, srv.lastNormalView)
+	tmpFile := srv.stateFile + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, []byte(data), 0644); err != nil {
+		fmt.Printf("Error: Could not persist view: %s \n", err)
+		return
+	}
+	if err := os.Rename(tmpFile, srv.stateFile); err != nil {
+		fmt.Printf("Error: Could not persist view: %s \n", err)
+	}
+}
+
+//loadPersistedView reads the view numbers written by persistView. ok is false if this server never ran before
+func loadPersistedView(stateFile string) (view int, lastNormalView int, ok bool) {
+	data, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		return 0, 0, false
+	}
+	if _, err := fmt.Sscanf(string(data), "%d %d", &view, &lastNormalView); err != nil {
+		//The server did run before, so it still has to recover
+		fmt.Printf("Error: Could not read persisted view: %s \n", err)
+		return 0, 0, true
+	}
+	return view, lastNormalView, true
+}
 
+//hashEntry chains a log entry to the hash of the entry before it
+func hashEntry(prevHash string, command string) string {
+	sum := sha256.Sum256([]byte(prevHash + command))
+	return hex.EncodeToString(sum[:])
+}
+
+//lastHash returns the hash of the latest entry in the log
+func (srv *server) lastHash() string {
+	return srv.log[len(srv.log)-1].Hash
+}
+
+//LogHash returns this server's hash at the requested op. Since hashes are chained, equal hashes at an op mean
+//the logs are identical up to that op
+func (srv *server) LogHash(ctx context.Context, args *pb.LogHashArgs) (*pb.LogHashReply, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	reply := &pb.LogHashReply{OpNo: int32(srv.opNo)}
+	if args.Op >= 0 && int(args.Op) < len(srv.log) {
+		reply.Hash = srv.log[args.Op].Hash
+		reply.Found = true
+	}
 	return reply, nil
+}
 
-	return
+//CompareLogs is an admin RPC which compares the hash at an op across replicas and reports where
+//each replica's log first diverged from this server's log
+func (srv *server) CompareLogs(ctx context.Context, args *pb.CompareLogsArgs) (*pb.CompareLogsReply, error) {
+	srv.mu.Lock()
+	hashes := make([]string, len(srv.log))
+	for i, entry := range srv.log {
+		hashes[i] = entry.Hash
+	}
+	srv.mu.Unlock()
+
+	op := int(args.Op)
+	if op <= 0 {
+		op = len(hashes) - 1
+	}
+	if op >= len(hashes) {
+		return nil, errors.New("op is beyond the end of the log")
+	}
+	reply := &pb.CompareLogsReply{Op: int32(op), Hash: hashes[op]}
+
+	for i := range srv.peers {
+		if i == srv.me {
+			continue
+		}
+		reply.Replicas = append(reply.Replicas, srv.compareLogWith(i, hashes, op))
+	}
+	return reply, nil
+}
+
+//compareLogWith compares the peer's log with our hashes up to op, binary searching for the first divergent op
+func (srv *server) compareLogWith(peer int, hashes []string, op int) *pb.LogComparison {
+	comparison := &pb.LogComparison{Server: int32(peer)}
+	peerReply, err := srv.peerLogHash(peer, op)
+	if err != nil {
+		return comparison
+	}
+	comparison.Reachable = true
+	comparison.OpNo = peerReply.OpNo
+	comparison.Hash = peerReply.Hash
+
+	//Only the prefix both logs have can be compared
+	common := op
+	commonHash := peerReply.Hash
+	if !peerReply.Found {
+		common = int(peerReply.OpNo)
+		if common <= 0 {
+			return comparison
+		}
+		peerReply, err = srv.peerLogHash(peer, common)
+		if err != nil || !peerReply.Found {
+			comparison.Reachable = err == nil
+			return comparison
+		}
+		commonHash = peerReply.Hash
+	}
+	if commonHash == hashes[common] {
+		return comparison
+	}
+
+	comparison.Diverged = true
+	lo, hi := 1, common
+	for lo < hi {
+		mid := (lo + hi) / 2
+		midReply, err := srv.peerLogHash(peer, mid)
+		if err != nil {
+			comparison.Reachable = false
+			return comparison
+		}
+		if midReply.Found && midReply.Hash == hashes[mid] {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	comparison.FirstDivergentOp = int32(lo)
+	fmt.Printf("Debug: Log of server %d diverged from server %d at op %d \n", peer, srv.me, lo)
+	return comparison
+}
+
+func (srv *server) peerLogHash(peer int, op int) (*pb.LogHashReply, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return srv.peerRPC[peer].LogHash(ctx, &pb.LogHashArgs{Op: int32(op)})
 }
 
 func (srv *server) PromptViewChange(ctx context.Context, args *pb.PromptViewChangeArgs) (reply *pb.PromptViewChangeReply, err error) {
@@ -597,6 +1693,11 @@ func (srv *server) PromptViewChange(ctx context.Context, args *pb.PromptViewChan
 	newView := int(args.NewView)
 	newPrimary := GetPrimary(newView, len(srv.peers))
 
+	//A recovering server has lost its log and can not lead a view change
+	if srv.status == RECOVERING {
+		return &pb.PromptViewChangeReply{Success: false}, errors.New("server is recovering")
+	}
+
 	if newPrimary != srv.me { //only primary of newView should do view change
 		return
 	} else if newView <= srv.currentView {
@@ -663,7 +1764,7 @@ func (srv *server) PromptViewChange(ctx context.Context, args *pb.PromptViewChan
 	return &pb.PromptViewChangeReply{Success:true}, nil
 }
 
-func (srv *server) determineNewViewLog(successReplies []*pb.ViewChangeReply) (ok bool,log []string)  {
+func (srv *server) determineNewViewLog(successReplies []*pb.ViewChangeReply) (ok bool,log []*pb.LogEntry)  {
 	// Your code here
 	lenSucess:=len(successReplies)
 	Majority:=(len(srv.peers)-1)/2+1
@@ -692,13 +1793,19 @@ func (srv *server) determineNewViewLog(successReplies []*pb.ViewChangeReply) (ok
 }
 
 func (srv *server) StartView(ctx context.Context, args *pb.StartViewArgs) (reply *pb.StartViewReply, err error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
 	if(srv.currentView>int(args.View)){
 		return &pb.StartViewReply{}, errors.New("start view failed")
 	}
+	if(srv.status==RECOVERING){
+		return &pb.StartViewReply{}, errors.New("start view failed, server is recovering")
+	}
 	fmt.Printf("Debug: Starting new view \n")
 	srv.currentView=int(args.View)
 	//srv.log=args.Log
 	srv.status=NORMAL
+	srv.persistView()
 	//srv.opNo=len(srv.log)-1
 	fmt.Printf("Debug: We have a new primary Server %d \n",GetPrimary(int(args.View),len(srv.peers)))
 	return &pb.StartViewReply{}, nil
@@ -707,11 +1814,17 @@ func (srv *server) StartView(ctx context.Context, args *pb.StartViewArgs) (reply
 
 func (srv *server) ViewChange(ctx context.Context, args *pb.ViewChangeArgs) (reply *pb.ViewChangeReply,err error) {
 	// Your code here
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
 	reply = &pb.ViewChangeReply{}
 	if(int(args.View)<=srv.currentView){
 		reply.Success=false
 		return reply, errors.New("Debug: Server View greater than ViewChange Request")
 	}
+	if(srv.status==RECOVERING){
+		reply.Success=false
+		return reply, errors.New("Debug: Server is recovering and can not take part in a view change")
+	}
 	fmt.Printf("Debug: We need a new Primary, Server %d is trying to become the primary \n",GetPrimary(int(args.View),len(srv.peers)));
 	fmt.Println("Debug: Starting view change")
 	reply.LastNormalView=int32(srv.currentView)
@@ -720,13 +1833,15 @@ func (srv *server) ViewChange(ctx context.Context, args *pb.ViewChangeArgs) (rep
 	srv.lastNormalView=srv.currentView
 	srv.currentView=int(args.View)
 	srv.status=VIEWCHANGE
+	srv.persistView()
 	return reply, nil
 }
 
 func main() {
 
 	//fetch ServerID to know index in peers list
-	ServerID, err := strconv.Atoi(os.Args[1])
+	flag.Parse()
+	ServerID, err := strconv.Atoi(flag.Arg(0))
 	if err != nil {
 		//handle Error
 		fmt.Println("Debug: Invalid ServerID, Exit", err)
@@ -740,9 +1855,11 @@ func main() {
 		lastNormalView: 0,
 		status:         NORMAL,
 		opNo:           0,
+		stateFile:      fmt.Sprintf("replica%d.state", ServerID),
 	}
+	rand.Seed(time.Now().UnixNano())
 
-	srv.log = append(srv.log, "")
+	srv.log = append(srv.log, &pb.LogEntry{})
 	srv.peers = append(srv.peers, ":50051")
 	srv.peers = append(srv.peers, ":50052")
 	srv.peers = append(srv.peers, ":50053")
@@ -753,6 +1870,42 @@ func main() {
 		os.Exit(2)
 	}
 
+	//A persisted view means this server crashed and restarted. It has lost its log and data, so it
+	//has to recover before it can take part in replication again
+	view, lastNormalView, restarted := loadPersistedView(srv.stateFile)
+	if restarted {
+		fmt.Printf("Debug: Server %d restarted in view %d, entering recovery \n", srv.me, view)
+		srv.currentView = view
+		srv.lastNormalView = lastNormalView
+		srv.status = RECOVERING
+	} else {
+		srv.persistView()
+	}
+
+	//Open the storage engine. A fresh server starts with an empty log, so it also starts with empty data
+	if *boltFile == "" {
+		*boltFile = fmt.Sprintf("replica%d.db", ServerID)
+	}
+	srv.store, err = openStore(*storeEngine, *boltFile)
+	if err != nil {
+		fmt.Printf("Debug: Could not open %s storage engine: %s \n", *storeEngine, err)
+		os.Exit(2)
+	}
+	defer srv.store.Close()
+	if *blobDir == "" {
+		*blobDir = fmt.Sprintf("replica%d.blobs", ServerID)
+	}
+	srv.blobs, err = openBlobStore(*blobDir)
+	if err != nil {
+		fmt.Printf("Debug: Could not open blob directory %s: %s \n", *blobDir, err)
+		os.Exit(2)
+	}
+	if !restarted {
+		srv.store.Update(func(tx *Tx) error {
+			return tx.Reset()
+		})
+	}
+
 	//Set up listener on your own port
 	lis, err := net.Listen("tcp", srv.peers[srv.me])
 	if err != nil {
@@ -764,7 +1917,8 @@ func main() {
 
 	//Set up rpccaller objects to other peer servers
 	for index, port := range srv.peers {
-		conn, err := grpc.Dial(port, grpc.WithInsecure())
+		conn, err := grpc.Dial(port, grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)))
 		if err != nil {
 			fmt.Printf("did not connect to port %s \n",port)
 			//log.Fatal("Error: %s",err)
@@ -816,7 +1970,17 @@ func main() {
 		}
 	}
 
-	s := grpc.NewServer()
+	if restarted {
+		go srv.startRecovery()
+	}
+	go srv.antiEntropy()
+	go srv.publishScheduledTweets()
+	if *deleteGrace > 0 {
+		go srv.purgeDeletedAccounts()
+	}
+
+	s := grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize),
+		grpc.UnaryInterceptor(srv.applyInterceptor))
 	pb.RegisterGreeterServer(s, srv)
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Anti-entropy: every replica periodically compares Merkle digests of its user data with the primary's.
+//Users are spread over a fixed number of buckets, each bucket is a leaf of the tree and a mismatching
+//leaf is a divergent range which is repaired by copying the primary's users of that bucket.
+
+const (
+	antiEntropyBuckets  = 16 // leaves of every Merkle tree, must be a power of two
+	antiEntropyInterval = 10 * time.Second
+)
+
+var antiEntropyRepair = true //if set to false divergent ranges are only reported, not repaired
+
+//the kinds of state a tree is built over
+var merkleKinds = []string{"users", "tweets", "follows", "likes", "notifications", "messages", "blocks", "votes", "scheduled", "lists"}
+
+//userBucket returns the bucket, i.e. the leaf of the Merkle trees, a user belongs to
+func userBucket(username string) int {
+	sum := sha256.Sum256([]byte(username))
+	return int(sum[0]) % antiEntropyBuckets
+}
+
+//writeLeaf serializes the part of a user covered by the tree of the given kind to the hash of its leaf
+func writeLeaf(w io.Writer, tx *Tx, kind string, user User) {
+	switch kind {
+	case "users":
+		fmt.Fprintf(w, "%s\x00%s\x00%d\x00%t\x00%t\x00%s\x00%s\x00%s\x00%s\x00%s", user.Username, user.Password, user.DeletedAt,
+			user.OpenDMs, user.Protected, user.DisplayName, user.Bio, user.Location, user.Website, user.Avatar)
+	case "tweets":
+		fmt.Fprintf(w, "%s\n", user.Username)
+		tx.ForEachTweet(user.Username, func(t tweet) error {
+			fmt.Fprintf(w, "%d %d %d %d %d %d %d %d %d %d %s %s %s\x00", t.ID, t.Timestamp, t.EditedAt, t.Likes,
+				t.RetweetOf, t.QuoteOf, t.Retweets, t.Quotes, t.ReplyTo, t.Replies, strings.Join(t.Mentions, ","),
+				strings.Join(t.Hashtags, ","), t.Text)
+			for _, m := range t.Media {
+				fmt.Fprintf(w, "%s %s\x00", m.ID, m.Thumbnail)
+			}
+			if t.Poll != nil {
+				fmt.Fprintf(w, "poll %d\x00", t.Poll.ExpiresAt)
+				for _, option := range t.Poll.Options {
+					fmt.Fprintf(w, "%d %s\x00", option.Votes, option.Text)
+				}
+			}
+			for _, edit := range t.History {
+				fmt.Fprintf(w, "%d %s\x00", edit.Timestamp, edit.Text)
+			}
+			return nil
+		})
+	case "follows":
+		fmt.Fprintf(w, "%s\n", user.Username)
+		tx.ForEachFollow(user.Username, func(followed string) error {
+			fmt.Fprintf(w, "%s\x00", followed)
+			return nil
+		})
+		tx.ForEachRequested(user.Username, func(account string) error {
+			fmt.Fprintf(w, "requested %s\x00", account)
+			return nil
+		})
+	case "notifications":
+		fmt.Fprintf(w, "%s %d\n", user.Username, tx.NotificationsRead(user.Username))
+		for _, n := range tx.notifications(user.Username) {
+			fmt.Fprintf(w, "%d %s %s %d %d\x00", n.ID, n.Kind, n.Actor, n.TweetID, n.Timestamp)
+		}
+	case "messages":
+		fmt.Fprintf(w, "%s\n", user.Username)
+		tx.ForEachConversation(user.Username, func(c dmConversation) error {
+			fmt.Fprintf(w, "%d %d %s\x00", c.ID, c.LastMessage, strings.Join(c.Members, ","))
+			return nil
+		})
+		tx.ForEachMessage(user.Username, 0, -1, func(m directMessage) error {
+			fmt.Fprintf(w, "%d %d %s %d %s\x00", m.ID, m.ConversationID, m.Sender, m.Timestamp, m.Text)
+			return nil
+		})
+	case "blocks":
+		fmt.Fprintf(w, "%s\n", user.Username)
+		for _, relation := range []string{blockRelation, muteRelation} {
+			tx.ForEachRelation(relation, user.Username, func(target string) error {
+				fmt.Fprintf(w, "%s %s\x00", relation, target)
+				return nil
+			})
+		}
+	case "scheduled":
+		fmt.Fprintf(w, "%s\n", user.Username)
+		tx.ForEachScheduled(user.Username, func(st scheduledTweet) error {
+			fmt.Fprintf(w, "%d %d %d %d %s %s\x00", st.ID, st.PublishAt, st.CreatedAt, st.PollDuration,
+				strings.Join(st.PollOptions, ","), st.Text)
+			for _, m := range st.Media {
+				fmt.Fprintf(w, "%s %s\x00", m.ID, m.Thumbnail)
+			}
+			return nil
+		})
+	case "lists":
+		fmt.Fprintf(w, "%s\n", user.Username)
+		tx.ForEachList(user.Username, func(l userList) error {
+			fmt.Fprintf(w, "%d %d %t %s %s %s\x00", l.ID, l.CreatedAt, l.Private, strings.Join(tx.listMembers(l.ID), ","),
+				l.Name, l.Description)
+			return nil
+		})
+	case "votes":
+		fmt.Fprintf(w, "%s\n", user.Username)
+		for _, v := range tx.votes(user.Username) {
+			fmt.Fprintf(w, "%d %d\x00", v.TweetID, v.Option)
+		}
+	default:
+		fmt.Fprintf(w, "%s\n", user.Username)
+		for _, id := range tx.likedIDs(user.Username) {
+			fmt.Fprintf(w, "%d\x00", id)
+		}
+	}
+	fmt.Fprint(w, "\n")
+}
+
+func hashString(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+//buildMerkleTrees computes one tree per kind. Nodes are in heap order: the root is node 0, the
+//children of node i are 2i+1 and 2i+2 and the bucket leaves are the last antiEntropyBuckets nodes
+func buildMerkleTrees(tx *Tx) []*pb.MerkleTree {
+	leaves := make([][]hash.Hash, len(merkleKinds))
+	for i := range merkleKinds {
+		leaves[i] = make([]hash.Hash, antiEntropyBuckets)
+		for b := range leaves[i] {
+			leaves[i][b] = sha256.New()
+		}
+	}
+	//Users are iterated in username order, so every server builds the leaves the same way
+	tx.ForEachUser(func(user User) error {
+		b := userBucket(user.Username)
+		for i, kind := range merkleKinds {
+			writeLeaf(leaves[i][b], tx, kind, user)
+		}
+		return nil
+	})
+
+	var trees []*pb.MerkleTree
+	for k, kind := range merkleKinds {
+		nodes := make([]string, 2*antiEntropyBuckets-1)
+		for b, leaf := range leaves[k] {
+			nodes[antiEntropyBuckets-1+b] = hex.EncodeToString(leaf.Sum(nil))
+		}
+		for i := antiEntropyBuckets - 2; i >= 0; i-- {
+			nodes[i] = hashString(nodes[2*i+1] + nodes[2*i+2])
+		}
+		trees = append(trees, &pb.MerkleTree{Kind: kind, Nodes: nodes})
+	}
+	return trees
+}
+
+//divergentBuckets walks both trees from the root and returns the leaves that differ
+func divergentBuckets(local []string, remote []string, node int, buckets []int) []int {
+	if local[node] == remote[node] {
+		return buckets
+	}
+	if node >= antiEntropyBuckets-1 {
+		return append(buckets, node-(antiEntropyBuckets-1))
+	}
+	buckets = divergentBuckets(local, remote, 2*node+1, buckets)
+	return divergentBuckets(local, remote, 2*node+2, buckets)
+}
+
+//snapshot starts a read-only transaction holding mu and calls fn in it once mu is released. Holding opMu on the
+//primary or applyMu on a backup no operation is applied while the transaction starts, so the state fn sees is
+//the one at the op number read holding mu, while new operations are applied as fn runs. fn is only called if
+//start, which is called holding mu, returns true
+func (srv *server) snapshot(mu *sync.Mutex, start func() bool, fn func(tx *Tx)) bool {
+	mu.Lock()
+	locked := true
+	defer func() {
+		if locked {
+			mu.Unlock()
+		}
+	}()
+	if !start() {
+		return false
+	}
+	err := srv.store.View(func(tx *Tx) error {
+		mu.Unlock()
+		locked = false
+		fn(tx)
+		return nil
+	})
+	return err == nil
+}
+
+//StateDigest returns the Merkle trees over this server's user data, at the returned op number
+func (srv *server) StateDigest(ctx context.Context, args *pb.StateDigestArgs) (*pb.StateDigestReply, error) {
+	if _, status := srv.viewStatus(); status != NORMAL {
+		return &pb.StateDigestReply{Success: false}, errors.New("server is not in NORMAL status")
+	}
+	reply := &pb.StateDigestReply{}
+	reply.Success = srv.snapshot(&srv.opMu, func() bool {
+		reply.OpNo = int32(srv.currentOp())
+		return true
+	}, func(tx *Tx) {
+		reply.Trees = buildMerkleTrees(tx)
+	})
+	return reply, nil
+}
+
+//StateRange returns all users falling in the requested buckets, used to repair divergent ranges. Like the digests
+//the users are those at the returned op number
+func (srv *server) StateRange(ctx context.Context, args *pb.StateRangeArgs) (*pb.StateRangeReply, error) {
+	if _, status := srv.viewStatus(); status != NORMAL {
+		return &pb.StateRangeReply{Success: false}, errors.New("server is not in NORMAL status")
+	}
+	requested := make(map[int]bool)
+	for _, b := range args.Buckets {
+		requested[int(b)] = true
+	}
+	reply := &pb.StateRangeReply{}
+	reply.Success = srv.snapshot(&srv.opMu, func() bool {
+		reply.OpNo = int32(srv.currentOp())
+		return true
+	}, func(tx *Tx) {
+		tx.ForEachUser(func(user User) error {
+			if requested[userBucket(user.Username)] {
+				reply.Data = append(reply.Data, userToData(tx, srv.blobs, user))
+			}
+			return nil
+		})
+	})
+	return reply, nil
+}
+
+//antiEntropy runs in the background for the lifetime of the server
+func (srv *server) antiEntropy() {
+	for {
+		time.Sleep(antiEntropyInterval)
+		srv.antiEntropyRound()
+	}
+}
+
+//antiEntropyRound compares this backup's digests with the primary's and repairs or flags divergent ranges
+func (srv *server) antiEntropyRound() {
+	view, status := srv.viewStatus()
+	primary := GetPrimary(view, len(srv.peers))
+	if status != NORMAL || primary == srv.me {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	remote, err := srv.peerRPC[primary].StateDigest(ctx, &pb.StateDigestArgs{})
+	if err != nil || !remote.Success {
+		debugPrint("Debug: Anti-entropy could not get digests from the primary")
+		return
+	}
+	//Digests only agree if both servers have applied the same operations. The local trees are built from the
+	//state at the op number of the primary's trees
+	op := int(remote.OpNo)
+	var local []*pb.MerkleTree
+	consistent := srv.snapshot(&srv.applyMu, func() bool {
+		return srv.appliedThrough(op)
+	}, func(tx *Tx) {
+		local = buildMerkleTrees(tx)
+	})
+	if !consistent {
+		return
+	}
+
+	divergent := make(map[int]bool)
+	for i, tree := range local {
+		if i >= len(remote.Trees) || len(remote.Trees[i].Nodes) != len(tree.Nodes) {
+			debugPrint("Debug: Anti-entropy got malformed digests from the primary")
+			return
+		}
+		for _, b := range divergentBuckets(tree.Nodes, remote.Trees[i].Nodes, 0, nil) {
+			fmt.Printf("Debug: Anti-entropy found divergent %s in bucket %d \n", tree.Kind, b)
+			divergent[b] = true
+		}
+	}
+	if len(divergent) == 0 || !antiEntropyRepair {
+		return
+	}
+
+	args := &pb.StateRangeArgs{}
+	for b := range divergent {
+		args.Buckets = append(args.Buckets, int32(b))
+	}
+	rangeReply, err := srv.peerRPC[primary].StateRange(ctx, args)
+	if err != nil || !rangeReply.Success {
+		debugPrint("Debug: Anti-entropy could not get divergent ranges from the primary")
+		return
+	}
+
+	//The ranges replace local state only if both sides are still at the op number the digests were compared at,
+	//a later operation applied by either server would be lost or applied twice otherwise
+	srv.applyMu.Lock()
+	defer srv.applyMu.Unlock()
+	if int(rangeReply.OpNo) != op || !srv.appliedThrough(op) {
+		debugPrint("Debug: Anti-entropy skipped the repair, operations were applied since the digests were compared")
+		return
+	}
+
+	//Replace the divergent buckets with the primary's users
+	err = srv.store.Update(func(tx *Tx) error {
+		var stale []string
+		tx.ForEachUser(func(user User) error {
+			if divergent[userBucket(user.Username)] {
+				stale = append(stale, user.Username)
+			}
+			return nil
+		})
+		for _, username := range stale {
+			if err := tx.DeleteUser(username); err != nil {
+				return err
+			}
+		}
+		for _, data := range rangeReply.Data {
+			if err := putUserData(tx, srv.blobs, data); err != nil {
+				return err
+			}
+		}
+		//The repaired users' tweets may belong in the timelines of users in other buckets
+		return tx.RebuildTimelines()
+	})
+	if err != nil {
+		fmt.Printf("Error: Anti-entropy could not repair divergent ranges: %s \n", err)
+		return
+	}
+	fmt.Printf("Debug: Anti-entropy repaired %d buckets from primary %d \n", len(divergent), primary)
+}
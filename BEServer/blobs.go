@@ -0,0 +1,137 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Images are kept as blobs in a local directory of every server, outside the storage engine. A blob is named by
+//the SHA-256 of its content, so every server stores an image under the same ID and writing it twice changes
+//nothing. Images are uploaded to every server before the operation referencing them is logged, the operation
+//only carries the blob ID. A blob left behind by an upload which is never referenced stays unused
+
+const uploadDeadline = 5 * time.Second // time a backup has to store an uploaded image
+
+var errNoSuchBlob = errors.New("no such blob")
+var errNotAnImage = errors.New("file is not a PNG, JPEG or GIF image")
+
+//imageTypes are the content types accepted for images
+var imageTypes = map[string]bool{"image/png": true, "image/jpeg": true, "image/gif": true}
+
+type blobStore struct {
+	dir string
+}
+
+func openBlobStore(dir string) (*blobStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &blobStore{dir: dir}, nil
+}
+
+//blobID returns the ID a blob with the given content is stored under
+func blobID(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+//checkImage returns an error unless data is an image of an accepted type no larger than limit
+func checkImage(data []byte, limit int) error {
+	if len(data) > limit {
+		return errors.New("image is too large")
+	}
+	if !imageTypes[http.DetectContentType(data)] {
+		return errNotAnImage
+	}
+	return nil
+}
+
+func (b *blobStore) path(id string) (string, error) {
+	if _, err := hex.DecodeString(id); err != nil || len(id) != 2*sha256.Size {
+		return "", errNoSuchBlob
+	}
+	return filepath.Join(b.dir, id), nil
+}
+
+//Put stores a blob and returns its ID
+func (b *blobStore) Put(data []byte) (string, error) {
+	id := blobID(data)
+	path, _ := b.path(id)
+	if _, err := os.Stat(path); err == nil {
+		return id, nil
+	}
+	//the blob is renamed into place once it is complete, a crash never leaves a partial blob behind
+	tmp, err := ioutil.TempFile(b.dir, "tmp-")
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return id, os.Rename(tmp.Name(), path)
+}
+
+//Get returns the content of a blob
+func (b *blobStore) Get(id string) ([]byte, error) {
+	path, err := b.path(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errNoSuchBlob
+	}
+	return data, err
+}
+
+//fetchBlob copies a blob this server is missing from a peer
+func (s *server) fetchBlob(id string) ([]byte, error) {
+	for i, rpccaller := range s.peerRPC {
+		if i == s.me {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		reply, err := rpccaller.GetBlob(ctx, &pb.BlobRequest{Id: id, Local: true})
+		cancel()
+		//a peer's copy is only taken if it has the content the ID names
+		if err != nil || blobID(reply.Data) != id {
+			continue
+		}
+		if _, err := s.blobs.Put(reply.Data); err != nil {
+			return nil, err
+		}
+		fmt.Printf("Debug: Fetched missing blob %s from server %d \n", id, i)
+		return reply.Data, nil
+	}
+	return nil, errNoSuchBlob
+}
+
+//GetBlob returns a stored image with its content type. A blob this server is missing is fetched from its peers
+func (s *server) GetBlob(ctx context.Context, in *pb.BlobRequest) (*pb.BlobReply, error) {
+	data, err := s.blobs.Get(in.Id)
+	if err == errNoSuchBlob && !in.Local {
+		if _, pathErr := s.blobs.path(in.Id); pathErr == nil {
+			data, err = s.fetchBlob(in.Id)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BlobReply{Data: data, ContentType: http.DetectContentType(data)}, nil
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//A block cuts two users off from each other: the follow edges between them are removed, neither sees the other's
+//tweets, and neither can follow, like, reply to or message the other. A mute only hides the muted user's tweets
+//and notifications from the user who muted it, the muted user does not notice
+
+const (
+	blockRelation = "block"
+	muteRelation  = "mute"
+)
+
+var errBlocked = errors.New("user is blocked")
+var errSelfRelation = errors.New("users cannot block or mute themselves")
+
+//relationBuckets returns the bucket of a relation and the bucket of its reverse index
+func relationBuckets(relation string) (string, string) {
+	if relation == blockRelation {
+		return blocksBucket, blockedByBucket
+	}
+	return mutesBucket, mutedByBucket
+}
+
+//Blocks reports whether the user blocked target
+func (tx *Tx) Blocks(username, target string) bool {
+	return tx.kv.get(blocksBucket, key(username, target)) != nil
+}
+
+//Mutes reports whether the user muted target
+func (tx *Tx) Mutes(username, target string) bool {
+	return tx.kv.get(mutesBucket, key(username, target)) != nil
+}
+
+//Blocked reports whether either user blocked the other
+func (tx *Tx) Blocked(a, b string) bool {
+	return tx.Blocks(a, b) || tx.Blocks(b, a)
+}
+
+//Hides reports whether the user does not see what author does, because either blocked the other or the user
+//muted author
+func (tx *Tx) Hides(username, author string) bool {
+	return username != author && (tx.Blocked(username, author) || tx.Mutes(username, author))
+}
+
+//HidesTweet reports whether a tweet is hidden from the user, because the user does not see its author or the
+//author's account is protected. A retweet is hidden with the tweet it shares
+func (tx *Tx) HidesTweet(username string, t tweet) bool {
+	if tx.Hides(username, t.Author) || !tx.CanView(username, t.Author) {
+		return true
+	}
+	if t.RetweetOf != 0 {
+		original, ok := tx.TweetByID(t.RetweetOf)
+		return ok && (tx.Hides(username, original.Author) || !tx.CanView(username, original.Author))
+	}
+	return false
+}
+
+func (tx *Tx) putRelation(relation, username, target string) error {
+	bucket, reverse := relationBuckets(relation)
+	if err := tx.kv.put(bucket, key(username, target), []byte{}); err != nil {
+		return err
+	}
+	return tx.kv.put(reverse, key(target, username), []byte{})
+}
+
+func (tx *Tx) deleteRelation(relation, username, target string) error {
+	bucket, reverse := relationBuckets(relation)
+	if err := tx.kv.del(bucket, key(username, target)); err != nil {
+		return err
+	}
+	return tx.kv.del(reverse, key(target, username))
+}
+
+//ForEachRelation calls fn for every user the user blocked or muted, in username order
+func (tx *Tx) ForEachRelation(relation, username string, fn func(target string) error) error {
+	bucket, _ := relationBuckets(relation)
+	prefix := key(username, "")
+	return tx.kv.forEach(bucket, prefix, func(k string, v []byte) error {
+		return fn(strings.TrimPrefix(k, prefix))
+	})
+}
+
+//relationsOf returns the users who blocked or muted the user
+func (tx *Tx) relationsOf(relation, username string) []string {
+	_, reverse := relationBuckets(relation)
+	prefix := key(username, "")
+	var users []string
+	tx.kv.forEach(reverse, prefix, func(k string, v []byte) error {
+		users = append(users, strings.TrimPrefix(k, prefix))
+		return nil
+	})
+	return users
+}
+
+//SetRelation blocks, unblocks, mutes or unmutes target. Blocking removes the follow edges, the follow requests and
+//the list memberships between the users
+func (tx *Tx) SetRelation(relation, username, target string, on bool) error {
+	if username == target {
+		return errSelfRelation
+	}
+	if _, ok := tx.ActiveUser(username); !ok {
+		return errNoSuchUser
+	}
+	if _, ok := tx.User(target); !ok {
+		return errNoSuchUser
+	}
+	if !on {
+		return tx.deleteRelation(relation, username, target)
+	}
+	if err := tx.putRelation(relation, username, target); err != nil {
+		return err
+	}
+	if relation == blockRelation {
+		for _, edge := range [][2]string{{username, target}, {target, username}} {
+			if err := tx.deleteFollowRequest(edge[0], edge[1]); err != nil {
+				return err
+			}
+			if err := tx.unlist(edge[0], edge[1]); err != nil {
+				return err
+			}
+			if !tx.IsFollowing(edge[0], edge[1]) {
+				continue
+			}
+			if err := tx.Unfollow(edge[0], edge[1]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//deleteRelations removes the blocks and mutes of the user. With purge set the blocks and mutes of other users
+//against the user are removed as well
+func (tx *Tx) deleteRelations(username string, purge bool) error {
+	for _, relation := range []string{blockRelation, muteRelation} {
+		var targets []string
+		tx.ForEachRelation(relation, username, func(target string) error {
+			targets = append(targets, target)
+			return nil
+		})
+		for _, target := range targets {
+			if err := tx.deleteRelation(relation, username, target); err != nil {
+				return err
+			}
+		}
+		if !purge {
+			continue
+		}
+		for _, other := range tx.relationsOf(relation, username) {
+			if err := tx.deleteRelation(relation, other, username); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//BlockUser blocks a user and removes the follow edges between the two users
+func (s *server) BlockUser(ctx context.Context, in *pb.BlockRequest) (*pb.BlockReply, error) {
+	return s.setRelation(in, blockRelation, true)
+}
+
+//UnblockUser takes a block back, the follow edges removed by the block are not restored
+func (s *server) UnblockUser(ctx context.Context, in *pb.BlockRequest) (*pb.BlockReply, error) {
+	return s.setRelation(in, blockRelation, false)
+}
+
+//MuteUser hides a user's tweets and notifications from the user
+func (s *server) MuteUser(ctx context.Context, in *pb.BlockRequest) (*pb.BlockReply, error) {
+	return s.setRelation(in, muteRelation, true)
+}
+
+func (s *server) UnmuteUser(ctx context.Context, in *pb.BlockRequest) (*pb.BlockReply, error) {
+	return s.setRelation(in, muteRelation, false)
+}
+
+//setRelation replicates and applies a block, unblock, mute or unmute
+func (s *server) setRelation(in *pb.BlockRequest, relation string, on bool) (*pb.BlockReply, error) {
+	operation := "Block"
+	if relation == muteRelation {
+		operation = "Mute"
+	}
+	if !on {
+		operation = "Un" + strings.ToLower(operation)
+	}
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		fmt.Printf("Debug: Discarding %s operation, server is recovering \n", operation)
+		return &pb.BlockReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//An operation which fails everywhere is not logged
+		var err error
+		s.store.View(func(tx *Tx) error {
+			if in.Username == in.Target {
+				err = errSelfRelation
+			} else if _, ok := tx.ActiveUser(in.Username); !ok {
+				err = errNoSuchUser
+			} else if _, ok := tx.User(in.Target); !ok {
+				err = errNoSuchUser
+			}
+			return nil
+		})
+		if err != nil {
+			return &pb.BlockReply{Status: false}, err
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			fmt.Printf("Debug: Discarding last %s operation \n", operation)
+			return &pb.BlockReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				var err error
+				switch {
+				case relation == blockRelation && on:
+					_, err = rpccaller.BlockUser(ctx, in)
+				case relation == blockRelation:
+					_, err = rpccaller.UnblockUser(ctx, in)
+				case on:
+					_, err = rpccaller.MuteUser(ctx, in)
+				default:
+					_, err = rpccaller.UnmuteUser(ctx, in)
+				}
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: %s of %s by %s replicated on Majority servers {Replication achieved} \n", operation, in.Target, in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: %s on all servers failed, applied only on %d servers", operation, count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.SetRelation(relation, in.Username, in.Target, on)
+	})
+	if err != nil {
+		fmt.Printf("Debug: %s of %s by %s failed: %s \n", operation, in.Target, in.Username, err)
+		return &pb.BlockReply{Status: false}, err
+	}
+	return &pb.BlockReply{Status: true}, nil
+}
+
+//ListBlocked returns a page of the users the user blocked
+func (s *server) ListBlocked(ctx context.Context, in *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return s.listFollows(in, func(tx *Tx, fn func(username string) error) error {
+		return tx.ForEachRelation(blockRelation, in.Username, fn)
+	})
+}
+
+//ListMuted returns a page of the users the user muted
+func (s *server) ListMuted(ctx context.Context, in *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return s.listFollows(in, func(tx *Tx, fn func(username string) error) error {
+		return tx.ForEachRelation(muteRelation, in.Username, fn)
+	})
+}
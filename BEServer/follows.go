@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/base64"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+const (
+	defaultFollowsLimit = 50
+	maxFollowsLimit     = 500
+)
+
+//A follows cursor is the last username of a page, the next page starts after it
+func encodeUsernameCursor(username string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(username))
+}
+
+func decodeUsernameCursor(cursor string) (string, error) {
+	username, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errBadCursor
+	}
+	return string(username), nil
+}
+
+//ListFollowing returns a page of the users the user follows
+func (s *server) ListFollowing(ctx context.Context, in *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return s.listFollows(in, func(tx *Tx, fn func(username string) error) error {
+		return tx.ForEachFollow(in.Username, fn)
+	})
+}
+
+//ListFollowers returns a page of the users following the user
+func (s *server) ListFollowers(ctx context.Context, in *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return s.listFollows(in, func(tx *Tx, fn func(username string) error) error {
+		return tx.ForEachFollower(in.Username, fn)
+	})
+}
+
+//listFollows pages through the usernames visited by forEach, which visits them in username order
+func (s *server) listFollows(in *pb.ListFollowsRequest, forEach func(tx *Tx, fn func(username string) error) error) (*pb.ListFollowsResponse, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultFollowsLimit
+	} else if limit > maxFollowsLimit {
+		limit = maxFollowsLimit
+	}
+	after := ""
+	if in.Cursor != "" {
+		username, err := decodeUsernameCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = username
+	}
+
+	response := &pb.ListFollowsResponse{}
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.User(in.Username); !ok {
+			return errNoSuchUser
+		}
+		return forEach(tx, func(username string) error {
+			//Deleted accounts are hidden until they are restored
+			user, ok := tx.ActiveUser(username)
+			if !ok {
+				return nil
+			}
+			response.Count++
+			if in.Cursor != "" && username <= after {
+				return nil
+			}
+			if len(response.Users) == limit {
+				response.NextCursor = encodeUsernameCursor(response.Users[limit-1].Username)
+				return nil
+			}
+			response.Users = append(response.Users, &pb.User{Username: username, Protected: user.Protected})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Hashtags are extracted when a tweet is added or edited and indexed twice on every server: by hashtag to list
+//the tweets using it, and by tweet ID, which sorts by time, to count the hashtags of recent tweets for the trends
+
+const (
+	defaultTrendsLimit = 10
+	maxTrendsLimit     = 50
+	defaultTrendWindow = 24 * time.Hour // trends count the tweets of the last day unless asked otherwise
+)
+
+var hashtagPattern = regexp.MustCompile(`#(\w+)`)
+
+//hashtags returns the hashtags of a text in lower case, in the order they are first used
+func hashtags(text string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, match := range hashtagPattern.FindAllStringSubmatch(text, -1) {
+		tag := strings.ToLower(match[1])
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func (tx *Tx) indexHashtags(t tweet) error {
+	for _, tag := range t.Hashtags {
+		if err := tx.kv.put(hashtagsBucket, key(tag, tweetIDKey(t.ID)), []byte(t.Author)); err != nil {
+			return err
+		}
+		if err := tx.kv.put(recentHashtagsBucket, key(tweetIDKey(t.ID), tag), []byte(t.Author)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *Tx) unindexHashtags(t tweet) error {
+	for _, tag := range t.Hashtags {
+		if err := tx.kv.del(hashtagsBucket, key(tag, tweetIDKey(t.ID))); err != nil {
+			return err
+		}
+		if err := tx.kv.del(recentHashtagsBucket, key(tweetIDKey(t.ID), tag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//ForEachHashtagTweet calls fn for the tweets using the hashtag which are older than before, newest first. A
+//negative before starts with the newest tweet
+func (tx *Tx) ForEachHashtagTweet(tag string, before int64, fn func(t tweet) error) error {
+	prefix := key(tag, "")
+	err := tx.kv.forEachReverse(hashtagsBucket, prefix, timelineKey(tag, before), func(k string, v []byte) error {
+		id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64)
+		if err != nil {
+			return err
+		}
+		if t, ok := tx.Tweet(string(v), id); ok {
+			return fn(t)
+		}
+		return nil
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//Trends counts the hashtags of the tweets created after since, up to now. Tweets of deleted accounts are
+//not counted
+func (tx *Tx) Trends(since int64, now int64) []*pb.Trend {
+	counts := map[string]*pb.Trend{}
+	authors := map[string]map[string]bool{}
+	active := map[string]bool{}
+	oldest := newTweetID(since, 0)
+	tx.kv.forEachReverse(recentHashtagsBucket, "", tweetIDKey(newTweetID(now+1, 0)), func(k string, v []byte) error {
+		parts := strings.SplitN(k, "\x00", 2)
+		id, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || len(parts) != 2 {
+			return nil
+		}
+		if id < oldest {
+			return errStopIteration
+		}
+		author := string(v)
+		if _, checked := active[author]; !checked {
+			_, active[author] = tx.ActiveUser(author)
+		}
+		if !active[author] {
+			return nil
+		}
+		tag := parts[1]
+		if counts[tag] == nil {
+			counts[tag] = &pb.Trend{Hashtag: tag}
+			authors[tag] = map[string]bool{}
+		}
+		counts[tag].Tweets++
+		if !authors[tag][author] {
+			authors[tag][author] = true
+			counts[tag].Authors++
+		}
+		return nil
+	})
+
+	//a hashtag used by many users trends above one a single user tweets often
+	var trends []*pb.Trend
+	for _, trend := range counts {
+		trends = append(trends, trend)
+	}
+	sort.Slice(trends, func(i, j int) bool {
+		if trends[i].Authors != trends[j].Authors {
+			return trends[i].Authors > trends[j].Authors
+		}
+		if trends[i].Tweets != trends[j].Tweets {
+			return trends[i].Tweets > trends[j].Tweets
+		}
+		return trends[i].Hashtag < trends[j].Hashtag
+	})
+	return trends
+}
+
+//TweetsByHashtag returns a page of the tweets using a hashtag, newest first
+func (s *server) TweetsByHashtag(ctx context.Context, in *pb.HashtagRequest) (*pb.HomeTimelineResponse, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+	before := int64(-1)
+	if in.Cursor != "" {
+		id, err := decodeCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = id
+	}
+	tag := strings.ToLower(strings.TrimPrefix(in.Hashtag, "#"))
+
+	response := &pb.HomeTimelineResponse{}
+	err := s.store.View(func(tx *Tx) error {
+		return tx.ForEachHashtagTweet(tag, before, func(t tweet) error {
+			//Tweets of deleted accounts are hidden until the accounts are restored
+			if _, ok := tx.ActiveUser(t.Author); !ok {
+				return nil
+			}
+			//Tweets of blocked and muted users are left out
+			if tx.HidesTweet(in.Username, t) {
+				return nil
+			}
+			if len(response.Tweets) == limit {
+				response.NextCursor = encodeCursor(response.Tweets[limit-1].Id)
+				return errStopIteration
+			}
+			response.Tweets = append(response.Tweets, tweetToProto(t))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.decorateTweets(in.Username, response.Tweets)
+	return response, nil
+}
+
+//GetTrends returns the hashtags used by the most users in a recent time window
+func (s *server) GetTrends(ctx context.Context, in *pb.TrendsRequest) (*pb.TrendsReply, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTrendsLimit
+	} else if limit > maxTrendsLimit {
+		limit = maxTrendsLimit
+	}
+	window := defaultTrendWindow
+	if in.WindowMinutes > 0 {
+		window = time.Duration(in.WindowMinutes) * time.Minute
+	}
+
+	now := nowMillis()
+	var trends []*pb.Trend
+	s.store.View(func(tx *Tx) error {
+		trends = tx.Trends(now-int64(window/time.Millisecond), now)
+		return nil
+	})
+	if len(trends) > limit {
+		trends = trends[:limit]
+	}
+	return &pb.TrendsReply{Trends: trends}, nil
+}
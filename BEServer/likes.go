@@ -0,0 +1,287 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+func likeKey(id int64, username string) string {
+	return key(tweetIDKey(id), username)
+}
+
+//HasLiked reports whether the user likes the tweet
+func (tx *Tx) HasLiked(username string, id int64) bool {
+	return tx.kv.get(likedBucket, tweetKey(username, id)) != nil
+}
+
+//Like records that the user likes the tweet and returns the tweet's like count. Liking a tweet twice changes nothing
+func (tx *Tx) Like(username string, id int64) (int, error) {
+	if _, ok := tx.ActiveUser(username); !ok {
+		return 0, errNoSuchUser
+	}
+	t, ok := tx.TweetByID(id)
+	if !ok {
+		return 0, errNoSuchTweet
+	}
+	if tx.Blocked(username, t.Author) {
+		return 0, errBlocked
+	}
+	if !tx.CanView(username, t.Author) {
+		return 0, errProtected
+	}
+	if tx.HasLiked(username, id) {
+		return t.Likes, nil
+	}
+	if err := tx.putLike(username, id); err != nil {
+		return 0, err
+	}
+	t.Likes++
+	return t.Likes, tx.putJSON(tweetsBucket, tweetKey(t.Author, id), t)
+}
+
+//Unlike removes the user's like of the tweet and returns the tweet's like count. Unliking a tweet the user does
+//not like changes nothing
+func (tx *Tx) Unlike(username string, id int64) (int, error) {
+	t, ok := tx.TweetByID(id)
+	if !ok {
+		return 0, errNoSuchTweet
+	}
+	if !tx.HasLiked(username, id) {
+		return t.Likes, nil
+	}
+	if err := tx.kv.del(likedBucket, tweetKey(username, id)); err != nil {
+		return 0, err
+	}
+	if err := tx.kv.del(likesBucket, likeKey(id, username)); err != nil {
+		return 0, err
+	}
+	t.Likes--
+	return t.Likes, tx.putJSON(tweetsBucket, tweetKey(t.Author, id), t)
+}
+
+//putLike adds the like edges only, the like counts are stored with the tweets
+func (tx *Tx) putLike(username string, id int64) error {
+	if err := tx.kv.put(likedBucket, tweetKey(username, id), []byte{}); err != nil {
+		return err
+	}
+	return tx.kv.put(likesBucket, likeKey(id, username), []byte{})
+}
+
+//likedIDs returns the IDs of the tweets the user likes, oldest first
+func (tx *Tx) likedIDs(username string) []int64 {
+	var ids []int64
+	prefix := key(username, "")
+	tx.kv.forEach(likedBucket, prefix, func(k string, v []byte) error {
+		if id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+		return nil
+	})
+	return ids
+}
+
+//ForEachLiked calls fn for the tweets the user likes older than before, newest first. A before below zero
+//starts at the newest tweet
+func (tx *Tx) ForEachLiked(username string, before int64, fn func(t tweet) error) error {
+	prefix := key(username, "")
+	err := tx.kv.forEachReverse(likedBucket, prefix, timelineKey(username, before), func(k string, v []byte) error {
+		id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64)
+		if err != nil {
+			return err
+		}
+		if t, ok := tx.TweetByID(id); ok {
+			return fn(t)
+		}
+		return nil
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//deleteLikes removes all likes of a tweet which is deleted
+func (tx *Tx) deleteLikes(id int64) error {
+	prefix := key(tweetIDKey(id), "")
+	var likers []string
+	tx.kv.forEach(likesBucket, prefix, func(k string, v []byte) error {
+		likers = append(likers, strings.TrimPrefix(k, prefix))
+		return nil
+	})
+	for _, liker := range likers {
+		if err := tx.kv.del(likedBucket, tweetKey(liker, id)); err != nil {
+			return err
+		}
+	}
+	return tx.deletePrefix(likesBucket, prefix)
+}
+
+func (s *server) LikeTweet(ctx context.Context, in *pb.LikeRequest) (*pb.LikeReply, error) {
+	return s.like(in, true)
+}
+
+func (s *server) UnlikeTweet(ctx context.Context, in *pb.LikeRequest) (*pb.LikeReply, error) {
+	return s.like(in, false)
+}
+
+//like replicates a LikeTweet or UnlikeTweet operation
+func (s *server) like(in *pb.LikeRequest, like bool) (*pb.LikeReply, error) {
+	operation := "Like"
+	if !like {
+		operation = "Unlike"
+	}
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		fmt.Printf("Debug: Discarding %s operation, server is recovering \n", operation)
+		return &pb.LikeReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//A retried operation which is already applied is not logged again
+		var t tweet
+		var exists, liked, blocked, visible bool
+		s.store.View(func(tx *Tx) error {
+			t, exists = tx.TweetByID(in.TweetId)
+			liked = tx.HasLiked(in.Username, in.TweetId)
+			blocked = tx.Blocked(in.Username, t.Author)
+			visible = tx.CanView(in.Username, t.Author)
+			return nil
+		})
+		if !exists {
+			return &pb.LikeReply{Status: false}, errNoSuchTweet
+		}
+		if like && blocked {
+			return &pb.LikeReply{Status: false}, errBlocked
+		}
+		if like && !visible {
+			return &pb.LikeReply{Status: false}, errProtected
+		}
+		if liked == like {
+			return &pb.LikeReply{Status: true, Likes: int32(t.Likes)}, nil
+		}
+
+		//The notification sent to the author gets its ID and time before the operation is logged
+		in.Timestamp = nowMillis()
+		s.store.View(func(tx *Tx) error {
+			in.NotificationId = tx.freeID(in.Timestamp, s.currentOp()+1, notificationIDsBucket)
+			return nil
+		})
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			fmt.Printf("Debug: Discarding last %s operation \n", operation)
+			return &pb.LikeReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				var err error
+				if like {
+					_, err = rpccaller.LikeTweet(ctx, in)
+				} else {
+					_, err = rpccaller.UnlikeTweet(ctx, in)
+				}
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: %s of tweet %d by %s replicated on Majority servers {Replication achieved} \n", operation, in.TweetId, in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: %s on all servers failed, applied only on %d servers", operation, count+1)
+		}
+	}
+
+	var likes int
+	err := s.store.Update(func(tx *Tx) error {
+		var err error
+		if like {
+			liked := tx.HasLiked(in.Username, in.TweetId)
+			if likes, err = tx.Like(in.Username, in.TweetId); err != nil || liked {
+				return err
+			}
+			t, _ := tx.TweetByID(in.TweetId)
+			n := notification{ID: in.NotificationId, Kind: likeNotification, Actor: in.Username, TweetID: in.TweetId, Timestamp: in.Timestamp}
+			return tx.Notify(t.Author, n)
+		} else {
+			likes, err = tx.Unlike(in.Username, in.TweetId)
+		}
+		return err
+	})
+	if err != nil {
+		fmt.Printf("Debug: %s of tweet %d by %s failed: %s \n", operation, in.TweetId, in.Username, err)
+		return &pb.LikeReply{Status: false}, err
+	}
+	return &pb.LikeReply{Status: true, Likes: int32(likes)}, nil
+}
+
+//ListLikedTweets returns a page of the tweets the user liked, newest tweet first
+func (s *server) ListLikedTweets(ctx context.Context, in *pb.HomeTimelineRequest) (*pb.HomeTimelineResponse, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+	before := int64(-1)
+	if in.Cursor != "" {
+		id, err := decodeCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = id
+	}
+
+	response := &pb.HomeTimelineResponse{}
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.User(in.Username); !ok {
+			return errNoSuchUser
+		}
+		return tx.ForEachLiked(in.Username, before, func(t tweet) error {
+			//Tweets of deleted accounts are hidden until the accounts are restored
+			if _, ok := tx.ActiveUser(t.Author); !ok {
+				return nil
+			}
+			if tx.HidesTweet(in.Username, t) {
+				return nil
+			}
+			if len(response.Tweets) == limit {
+				response.NextCursor = encodeCursor(response.Tweets[limit-1].Id)
+				return errStopIteration
+			}
+			response.Tweets = append(response.Tweets, tweetToProto(t))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.decorateTweets(in.Username, response.Tweets)
+	return response, nil
+}
@@ -0,0 +1,628 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//A list is a named set of accounts curated by its owner, independent of whom the owner follows. Lists are kept
+//with their owner like follow edges, and indexed by member so a purged account leaves every list it is on.
+//List timelines are not materialized: the members' tweets are merged when the timeline is read
+
+const (
+	maxListsPerUser    = 100
+	maxListMembers     = 500
+	maxListNameLength  = 25
+	maxListDescription = 100
+)
+
+var errNoSuchList = errors.New("no such list")
+var errNotListOwner = errors.New("only the owner can modify a list")
+var errTooManyLists = fmt.Errorf("a user has at most %d lists", maxListsPerUser)
+var errListFull = fmt.Errorf("a list has at most %d members", maxListMembers)
+
+type userList struct {
+	ID          int64
+	Owner       string
+	Name        string
+	Description string
+	Private     bool
+	CreatedAt   int64
+}
+
+func listKey(owner string, id int64) string {
+	return tweetKey(owner, id)
+}
+
+//checkList returns an error unless the name and description can be given to a list
+func checkList(name, description string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("a list needs a name")
+	}
+	if utf8.RuneCountInString(name) > maxListNameLength {
+		return fmt.Errorf("list names are at most %d characters", maxListNameLength)
+	}
+	if utf8.RuneCountInString(description) > maxListDescription {
+		return fmt.Errorf("list descriptions are at most %d characters", maxListDescription)
+	}
+	return nil
+}
+
+//List returns a list by its ID
+func (tx *Tx) List(id int64) (userList, bool) {
+	owner := tx.kv.get(listIDsBucket, tweetIDKey(id))
+	if owner == nil {
+		return userList{}, false
+	}
+	var l userList
+	ok := tx.getJSON(listsBucket, listKey(string(owner), id), &l)
+	return l, ok
+}
+
+//OwnList returns the list if it exists and username owns it
+func (tx *Tx) OwnList(username string, id int64) (userList, error) {
+	l, ok := tx.List(id)
+	if !ok {
+		return l, errNoSuchList
+	}
+	if l.Owner != username {
+		return l, errNotListOwner
+	}
+	return l, nil
+}
+
+//VisibleList returns the list if the viewer may see it: the owner's account is active, and the list is public or
+//the viewer owns it. Other lists do not exist for the viewer
+func (tx *Tx) VisibleList(viewer string, id int64) (userList, error) {
+	l, ok := tx.List(id)
+	if !ok {
+		return l, errNoSuchList
+	}
+	if _, active := tx.ActiveUser(l.Owner); !active || (l.Private && l.Owner != viewer) {
+		return userList{}, errNoSuchList
+	}
+	return l, nil
+}
+
+//CreateList adds a list owned by the user
+func (tx *Tx) CreateList(l userList) error {
+	if _, ok := tx.ActiveUser(l.Owner); !ok {
+		return errNoSuchUser
+	}
+	return tx.putList(l)
+}
+
+func (tx *Tx) putList(l userList) error {
+	if err := tx.putJSON(listsBucket, listKey(l.Owner, l.ID), l); err != nil {
+		return err
+	}
+	return tx.kv.put(listIDsBucket, tweetIDKey(l.ID), []byte(l.Owner))
+}
+
+//DeleteList removes one of the user's lists
+func (tx *Tx) DeleteList(username string, id int64) error {
+	if _, err := tx.OwnList(username, id); err != nil {
+		return err
+	}
+	for _, member := range tx.listMembers(id) {
+		if err := tx.kv.del(listedBucket, key(member, tweetIDKey(id))); err != nil {
+			return err
+		}
+	}
+	if err := tx.deletePrefix(listMembersBucket, key(tweetIDKey(id), "")); err != nil {
+		return err
+	}
+	if err := tx.kv.del(listsBucket, listKey(username, id)); err != nil {
+		return err
+	}
+	return tx.kv.del(listIDsBucket, tweetIDKey(id))
+}
+
+//IsListMember reports whether the user is a member of the list
+func (tx *Tx) IsListMember(id int64, username string) bool {
+	return tx.kv.get(listMembersBucket, key(tweetIDKey(id), username)) != nil
+}
+
+//AddListMember adds an account to one of the user's lists. Adding a member twice changes nothing
+func (tx *Tx) AddListMember(username string, id int64, member string) error {
+	if _, err := tx.OwnList(username, id); err != nil {
+		return err
+	}
+	if _, ok := tx.ActiveUser(member); !ok {
+		return errNoSuchUser
+	}
+	if tx.Blocked(username, member) {
+		return errBlocked
+	}
+	if tx.IsListMember(id, member) {
+		return nil
+	}
+	if len(tx.listMembers(id)) >= maxListMembers {
+		return errListFull
+	}
+	return tx.putListMember(id, member)
+}
+
+func (tx *Tx) putListMember(id int64, member string) error {
+	if err := tx.kv.put(listMembersBucket, key(tweetIDKey(id), member), []byte{}); err != nil {
+		return err
+	}
+	return tx.kv.put(listedBucket, key(member, tweetIDKey(id)), []byte{})
+}
+
+//RemoveListMember removes an account from one of the user's lists. Removing an account which is not a member
+//changes nothing
+func (tx *Tx) RemoveListMember(username string, id int64, member string) error {
+	if _, err := tx.OwnList(username, id); err != nil {
+		return err
+	}
+	return tx.deleteListMember(id, member)
+}
+
+func (tx *Tx) deleteListMember(id int64, member string) error {
+	if err := tx.kv.del(listMembersBucket, key(tweetIDKey(id), member)); err != nil {
+		return err
+	}
+	return tx.kv.del(listedBucket, key(member, tweetIDKey(id)))
+}
+
+//unlist removes member from all lists of owner
+func (tx *Tx) unlist(owner, member string) error {
+	var ids []int64
+	tx.ForEachList(owner, func(l userList) error {
+		ids = append(ids, l.ID)
+		return nil
+	})
+	for _, id := range ids {
+		if err := tx.deleteListMember(id, member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//ForEachList calls fn for the user's lists in creation order
+func (tx *Tx) ForEachList(username string, fn func(l userList) error) error {
+	return tx.kv.forEach(listsBucket, key(username, ""), func(k string, v []byte) error {
+		var l userList
+		if err := json.Unmarshal(v, &l); err != nil {
+			return err
+		}
+		return fn(l)
+	})
+}
+
+//listMembers returns the members of a list in username order
+func (tx *Tx) listMembers(id int64) []string {
+	var members []string
+	prefix := key(tweetIDKey(id), "")
+	tx.kv.forEach(listMembersBucket, prefix, func(k string, v []byte) error {
+		members = append(members, strings.TrimPrefix(k, prefix))
+		return nil
+	})
+	return members
+}
+
+//deleteLists removes the user's lists. With purge set the user is removed from the lists of other users as well
+func (tx *Tx) deleteLists(username string, purge bool) error {
+	var ids []int64
+	tx.ForEachList(username, func(l userList) error {
+		ids = append(ids, l.ID)
+		return nil
+	})
+	for _, id := range ids {
+		if err := tx.DeleteList(username, id); err != nil {
+			return err
+		}
+	}
+	if !purge {
+		return nil
+	}
+	var listed []int64
+	prefix := key(username, "")
+	tx.kv.forEach(listedBucket, prefix, func(k string, v []byte) error {
+		if id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64); err == nil {
+			listed = append(listed, id)
+		}
+		return nil
+	})
+	for _, id := range listed {
+		if err := tx.deleteListMember(id, username); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//listToProto converts a list, its members are only added if withMembers is set
+func (tx *Tx) listToProto(l userList, withMembers bool) *pb.UserList {
+	members := tx.listMembers(l.ID)
+	reply := &pb.UserList{Id: l.ID, Owner: l.Owner, Name: l.Name, Description: l.Description, Private: l.Private,
+		CreatedAt: l.CreatedAt, MemberCount: int32(len(members))}
+	if withMembers {
+		reply.Members = members
+	}
+	return reply
+}
+
+func protoToList(l *pb.UserList) userList {
+	return userList{ID: l.Id, Owner: l.Owner, Name: l.Name, Description: l.Description, Private: l.Private, CreatedAt: l.CreatedAt}
+}
+
+//CreateList adds a named list of accounts owned by the user
+func (s *server) CreateList(ctx context.Context, in *pb.CreateListRequest) (*pb.CreateListReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Create List operation, server is recovering")
+		return &pb.CreateListReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//A list which can not be created anywhere is not logged
+		if err := checkList(in.Name, in.Description); err != nil {
+			return &pb.CreateListReply{Status: false}, err
+		}
+		err := s.store.View(func(tx *Tx) error {
+			if _, ok := tx.ActiveUser(in.Username); !ok {
+				return errNoSuchUser
+			}
+			count := 0
+			tx.ForEachList(in.Username, func(l userList) error {
+				count++
+				return nil
+			})
+			if count >= maxListsPerUser {
+				return errTooManyLists
+			}
+			return nil
+		})
+		if err != nil {
+			return &pb.CreateListReply{Status: false}, err
+		}
+
+		//The list's ID and creation time are fixed before it is logged, so every server stores the same list
+		in.Timestamp = nowMillis()
+		s.store.View(func(tx *Tx) error {
+			in.ListId = tx.freeID(in.Timestamp, s.currentOp()+1, listIDsBucket)
+			return nil
+		})
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Create List operation")
+			return &pb.CreateListReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Create List RPC calls to all the backup servers
+				_, err := rpccaller.CreateList(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: List '%s' of %s replicated on Majority servers {Replication achieved} \n", in.Name, in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Creating list on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	l := userList{ID: in.ListId, Owner: in.Username, Name: in.Name, Description: in.Description, Private: in.Private,
+		CreatedAt: in.Timestamp}
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.CreateList(l)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Creating list '%s' of %s failed: %s \n", in.Name, in.Username, err)
+		return &pb.CreateListReply{Status: false}, err
+	}
+	return &pb.CreateListReply{Status: true, ListId: in.ListId}, nil
+}
+
+//DeleteList removes one of the user's lists
+func (s *server) DeleteList(ctx context.Context, in *pb.DeleteListRequest) (*pb.DeleteListReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Delete List operation, server is recovering")
+		return &pb.DeleteListReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Only the owner may delete the list, an operation which fails everywhere is not logged
+		err := s.store.View(func(tx *Tx) error {
+			_, err := tx.OwnList(in.Username, in.ListId)
+			return err
+		})
+		if err != nil {
+			return &pb.DeleteListReply{Status: false}, err
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Delete List operation")
+			return &pb.DeleteListReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Delete List RPC calls to all the backup servers
+				_, err := rpccaller.DeleteList(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Deletion of list %d of %s replicated on Majority servers {Replication achieved} \n", in.ListId, in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Deleting list on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.DeleteList(in.Username, in.ListId)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Deleting list %d of %s failed: %s \n", in.ListId, in.Username, err)
+		return &pb.DeleteListReply{Status: false}, err
+	}
+	return &pb.DeleteListReply{Status: true}, nil
+}
+
+func (s *server) AddListMember(ctx context.Context, in *pb.ListMemberRequest) (*pb.ListMemberReply, error) {
+	return s.listMember(in, true)
+}
+
+func (s *server) RemoveListMember(ctx context.Context, in *pb.ListMemberRequest) (*pb.ListMemberReply, error) {
+	return s.listMember(in, false)
+}
+
+//listMember replicates an AddListMember or RemoveListMember operation
+func (s *server) listMember(in *pb.ListMemberRequest, add bool) (*pb.ListMemberReply, error) {
+	operation := "Add List Member"
+	if !add {
+		operation = "Remove List Member"
+	}
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		fmt.Printf("Debug: Discarding %s operation, server is recovering \n", operation)
+		return &pb.ListMemberReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//An operation which fails everywhere is not logged, nor is one which would change nothing
+		var member bool
+		err := s.store.View(func(tx *Tx) error {
+			if _, err := tx.OwnList(in.Username, in.ListId); err != nil {
+				return err
+			}
+			member = tx.IsListMember(in.ListId, in.Member)
+			if !add || member {
+				return nil
+			}
+			if _, ok := tx.ActiveUser(in.Member); !ok {
+				return errNoSuchUser
+			}
+			if tx.Blocked(in.Username, in.Member) {
+				return errBlocked
+			}
+			if len(tx.listMembers(in.ListId)) >= maxListMembers {
+				return errListFull
+			}
+			return nil
+		})
+		if err != nil {
+			return &pb.ListMemberReply{Status: false}, err
+		}
+		if member == add {
+			return &pb.ListMemberReply{Status: true}, nil
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			fmt.Printf("Debug: Discarding last %s operation \n", operation)
+			return &pb.ListMemberReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				var err error
+				if add {
+					_, err = rpccaller.AddListMember(ctx, in)
+				} else {
+					_, err = rpccaller.RemoveListMember(ctx, in)
+				}
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: %s %s of list %d replicated on Majority servers {Replication achieved} \n", operation, in.Member, in.ListId)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: %s on all servers failed, applied only on %d servers", operation, count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		if add {
+			return tx.AddListMember(in.Username, in.ListId, in.Member)
+		}
+		return tx.RemoveListMember(in.Username, in.ListId, in.Member)
+	})
+	if err != nil {
+		fmt.Printf("Debug: %s %s of list %d failed: %s \n", operation, in.Member, in.ListId, err)
+		return &pb.ListMemberReply{Status: false}, err
+	}
+	return &pb.ListMemberReply{Status: true}, nil
+}
+
+//GetLists returns the lists a user owns, the private ones only to the user itself
+func (s *server) GetLists(ctx context.Context, in *pb.GetListsRequest) (*pb.GetListsReply, error) {
+	reply := &pb.GetListsReply{}
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.ActiveUser(in.Username); !ok {
+			return errNoSuchUser
+		}
+		return tx.ForEachList(in.Username, func(l userList) error {
+			if l.Private && in.Viewer != in.Username {
+				return nil
+			}
+			reply.Lists = append(reply.Lists, tx.listToProto(l, false))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+//GetList returns a list with its members
+func (s *server) GetList(ctx context.Context, in *pb.GetListRequest) (*pb.UserList, error) {
+	var reply *pb.UserList
+	err := s.store.View(func(tx *Tx) error {
+		l, err := tx.VisibleList(in.Viewer, in.ListId)
+		if err != nil {
+			return err
+		}
+		reply = tx.listToProto(l, true)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+//GetListTimeline merges the tweets of the members of a list, newest first
+func (s *server) GetListTimeline(ctx context.Context, in *pb.ListTimelineRequest) (*pb.HomeTimelineResponse, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+	before := int64(-1)
+	if in.Cursor != "" {
+		id, err := decodeCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = id
+	}
+
+	//Every member is read up to one tweet more than the page, to know whether there is a next page
+	var tweets []tweet
+	err := s.store.View(func(tx *Tx) error {
+		l, err := tx.VisibleList(in.Viewer, in.ListId)
+		if err != nil {
+			return err
+		}
+		for _, member := range tx.listMembers(l.ID) {
+			//Tweets of deleted accounts are hidden until the accounts are restored
+			if _, ok := tx.ActiveUser(member); !ok {
+				continue
+			}
+			n := 0
+			err := tx.ForEachTweetBefore(member, before, func(t tweet) error {
+				if tx.HidesTweet(in.Viewer, t) {
+					return nil
+				}
+				tweets = append(tweets, t)
+				n++
+				if n > limit {
+					return errStopIteration
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(tweets, func(i, j int) bool { return tweets[i].ID > tweets[j].ID })
+	response := &pb.HomeTimelineResponse{}
+	if len(tweets) > limit {
+		tweets = tweets[:limit]
+		response.NextCursor = encodeCursor(tweets[limit-1].ID)
+	}
+	for _, t := range tweets {
+		response.Tweets = append(response.Tweets, tweetToProto(t))
+	}
+	s.decorateTweets(in.Viewer, response.Tweets)
+	return response, nil
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Images attached to tweets are uploaded before the tweet is added. An upload stores the image and a thumbnail
+//as blobs on every server, outside the log: the AddTweet operation only carries the blob IDs. A server which
+//missed an upload fetches the blobs from its peers when they are asked for, and recovering servers receive
+//them with the tweets. Blobs of deleted tweets are kept
+
+const (
+	maxMediaSize        = 5 << 20 // largest image accepted
+	maxMediaDimension   = 8192    // largest width or height accepted, decoding larger images costs too much memory
+	maxMediaPerTweet    = 4
+	maxThumbnailSize    = 200 // thumbnails fit in a square of this size
+)
+
+var errTooManyMedia = fmt.Errorf("a tweet has at most %d images", maxMediaPerTweet)
+var errNoSuchMedia = errors.New("attached image was not uploaded")
+
+type media struct {
+	ID        string // blob ID of the image
+	Thumbnail string // blob ID of the thumbnail
+}
+
+//checkMedia returns an error unless data is an image which can be attached to a tweet
+func checkMedia(data []byte) error {
+	if err := checkImage(data, maxMediaSize); err != nil {
+		return err
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return errNotAnImage
+	}
+	if config.Width > maxMediaDimension || config.Height > maxMediaDimension {
+		return fmt.Errorf("images are at most %dx%d pixels", maxMediaDimension, maxMediaDimension)
+	}
+	return nil
+}
+
+//thumbnail returns a PNG copy of an image scaled down to fit maxThumbnailSize. The result only depends on the
+//image, so every server computes the same thumbnail blob
+func thumbnail(data []byte) ([]byte, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errNotAnImage
+	}
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, errNotAnImage
+	}
+	thumbWidth, thumbHeight := width, height
+	if width > maxThumbnailSize || height > maxThumbnailSize {
+		if width >= height {
+			thumbWidth, thumbHeight = maxThumbnailSize, height*maxThumbnailSize/width
+		} else {
+			thumbWidth, thumbHeight = width*maxThumbnailSize/height, maxThumbnailSize
+		}
+		if thumbWidth == 0 {
+			thumbWidth = 1
+		}
+		if thumbHeight == 0 {
+			thumbHeight = 1
+		}
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := 0; y < thumbHeight; y++ {
+		for x := 0; x < thumbWidth; x++ {
+			dst.Set(x, y, src.At(bounds.Min.X+x*width/thumbWidth, bounds.Min.Y+y*height/thumbHeight))
+		}
+	}
+	var out bytes.Buffer
+	if err := png.Encode(&out, dst); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+//storeMedia stores an image and its thumbnail as blobs
+func (s *server) storeMedia(data []byte) (media, error) {
+	if err := checkMedia(data); err != nil {
+		return media{}, err
+	}
+	thumb, err := thumbnail(data)
+	if err != nil {
+		return media{}, err
+	}
+	id, err := s.blobs.Put(data)
+	if err != nil {
+		return media{}, err
+	}
+	thumbID, err := s.blobs.Put(thumb)
+	if err != nil {
+		return media{}, err
+	}
+	return media{ID: id, Thumbnail: thumbID}, nil
+}
+
+//hasMedia reports whether both blobs of an attached image are stored on this server
+func (s *server) hasMedia(m media) bool {
+	for _, id := range []string{m.ID, m.Thumbnail} {
+		if _, err := s.blobs.Get(id); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+//checkAttachments returns an error unless the images of a tweet are stored on this server and its poll is valid
+func (s *server) checkAttachments(list []*pb.Media, options []string, duration int64) error {
+	if len(list) > maxMediaPerTweet {
+		return errTooManyMedia
+	}
+	for _, m := range protoToMedia(list) {
+		if !s.hasMedia(m) {
+			return errNoSuchMedia
+		}
+	}
+	if len(options) != 0 {
+		return checkPoll(options, duration)
+	}
+	return nil
+}
+
+func mediaToProto(list []media) []*pb.Media {
+	var reply []*pb.Media
+	for _, m := range list {
+		reply = append(reply, &pb.Media{Id: m.ID, Thumbnail: m.Thumbnail})
+	}
+	return reply
+}
+
+func protoToMedia(list []*pb.Media) []media {
+	var stored []media
+	for _, m := range list {
+		stored = append(stored, media{ID: m.Id, Thumbnail: m.Thumbnail})
+	}
+	return stored
+}
+
+//UploadMedia stores an image and its thumbnail on every server and returns their blob IDs, to be attached to a
+//tweet. The upload is not logged, blobs are named by their content so storing one twice changes nothing
+func (s *server) UploadMedia(ctx context.Context, in *pb.UploadMediaRequest) (*pb.UploadMediaReply, error) {
+	if in.Broadcast {
+		var exists bool
+		s.store.View(func(tx *Tx) error {
+			_, exists = tx.ActiveUser(in.Username)
+			return nil
+		})
+		if !exists {
+			return nil, errNoSuchUser
+		}
+	}
+	m, err := s.storeMedia(in.Data)
+	if err != nil {
+		fmt.Printf("Debug: Could not store image of %s: %s \n", in.Username, err)
+		return nil, err
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), uploadDeadline)
+				defer cancel()
+				//Upload Media RPC calls to all the backup servers
+				_, err := rpccaller.UploadMedia(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					count++
+				}
+			}
+		}
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Image %s stored on Majority servers \n", m.ID)
+		} else {
+			//servers missing the image fetch it from this one when it is asked for
+			fmt.Printf("Debug: Image %s stored only on %d servers \n", m.ID, count+1)
+		}
+	}
+	return &pb.UploadMediaReply{Media: &pb.Media{Id: m.ID, Thumbnail: m.Thumbnail}}, nil
+}
@@ -0,0 +1,490 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Direct messages are kept in a mailbox per user: every member of a conversation stores its own copy of the
+//conversation and its messages. A mailbox moves between servers with the rest of its owner's data and goes away
+//with its owner's account, while the other members keep their copies
+
+const maxConversationMembers = 10
+
+var errNoRecipients = errors.New("a conversation needs at least one other member")
+var errTooManyMembers = errors.New("too many members for a conversation")
+var errNotMember = errors.New("not a member of the conversation")
+var errDMNotAllowed = errors.New("user does not accept messages from you")
+var errEmptyMessage = errors.New("message is empty")
+
+type dmConversation struct {
+	ID          int64    // the ID of the conversation's first message
+	Members     []string // all members in username order
+	LastMessage int64    // the ID of the latest message
+}
+
+type directMessage struct {
+	ID             int64
+	ConversationID int64
+	Sender         string
+	Text           string
+	Timestamp      int64
+}
+
+//Conversation looks up one of the conversations in the user's mailbox
+func (tx *Tx) Conversation(username string, id int64) (dmConversation, bool) {
+	var c dmConversation
+	ok := tx.getJSON(conversationsBucket, tweetKey(username, id), &c)
+	return c, ok
+}
+
+func (tx *Tx) putConversation(username string, c dmConversation) error {
+	return tx.putJSON(conversationsBucket, tweetKey(username, c.ID), c)
+}
+
+func (tx *Tx) putMessage(username string, m directMessage) error {
+	if err := tx.kv.put(messageIDsBucket, key(tweetIDKey(m.ID), username), []byte{}); err != nil {
+		return err
+	}
+	return tx.putJSON(messagesBucket, key(username, tweetIDKey(m.ConversationID), tweetIDKey(m.ID)), m)
+}
+
+//deleteMailbox removes the user's copies of its conversations and messages
+func (tx *Tx) deleteMailbox(username string) error {
+	var ids []int64
+	tx.ForEachMessage(username, 0, -1, func(m directMessage) error {
+		ids = append(ids, m.ID)
+		return nil
+	})
+	for _, id := range ids {
+		if err := tx.kv.del(messageIDsBucket, key(tweetIDKey(id), username)); err != nil {
+			return err
+		}
+	}
+	if err := tx.deletePrefix(conversationsBucket, key(username, "")); err != nil {
+		return err
+	}
+	return tx.deletePrefix(messagesBucket, key(username, ""))
+}
+
+//ForEachConversation calls fn for every conversation in the user's mailbox, oldest conversation first
+func (tx *Tx) ForEachConversation(username string, fn func(c dmConversation) error) error {
+	return tx.kv.forEach(conversationsBucket, key(username, ""), func(k string, v []byte) error {
+		var c dmConversation
+		if err := json.Unmarshal(v, &c); err != nil {
+			return err
+		}
+		return fn(c)
+	})
+}
+
+//ForEachMessage calls fn for the messages of one of the user's conversations which are older than before,
+//newest first. A negative before starts with the newest message. With id 0 all of the user's messages are
+//visited
+func (tx *Tx) ForEachMessage(username string, id int64, before int64, fn func(m directMessage) error) error {
+	prefix := key(username, "")
+	if id != 0 {
+		prefix = key(username, tweetIDKey(id), "")
+	}
+	end := ""
+	if before >= 0 {
+		end = prefix + tweetIDKey(before)
+	}
+	err := tx.kv.forEachReverse(messagesBucket, prefix, end, func(k string, v []byte) error {
+		var m directMessage
+		if err := json.Unmarshal(v, &m); err != nil {
+			return err
+		}
+		return fn(m)
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//AcceptsMessagesFrom reports whether the user can be added to a conversation by sender: it has to follow
+//sender or accept messages from anyone
+func (tx *Tx) AcceptsMessagesFrom(username string, sender string) bool {
+	user, ok := tx.ActiveUser(username)
+	return ok && (user.OpenDMs || tx.IsFollowing(username, sender))
+}
+
+//SetOpenDMs sets whether users the user does not follow can start conversations with it
+func (tx *Tx) SetOpenDMs(username string, open bool) error {
+	user, ok := tx.ActiveUser(username)
+	if !ok {
+		return errNoSuchUser
+	}
+	user.OpenDMs = open
+	return tx.PutUser(user)
+}
+
+//messageTarget returns the conversation a message of the user goes to: the conversation with the given ID, or
+//else the user's conversation with exactly the recipients. If there is none a new conversation with the ID of
+//the message, newID, is returned
+func (tx *Tx) messageTarget(username string, id int64, recipients []string, newID int64) (dmConversation, error) {
+	if _, ok := tx.ActiveUser(username); !ok {
+		return dmConversation{}, errNoSuchUser
+	}
+	//nobody can message a user who blocked them or whom they blocked, in a group conversation neither
+	blocked := func(c dmConversation) error {
+		for _, member := range c.Members {
+			if tx.Blocked(username, member) {
+				return errBlocked
+			}
+		}
+		return nil
+	}
+	if id != 0 {
+		if c, ok := tx.Conversation(username, id); ok {
+			return c, blocked(c)
+		}
+		//the primary logs a new conversation with the ID of its first message
+		if id != newID {
+			return dmConversation{}, errNotMember
+		}
+	}
+
+	members := []string{username}
+	seen := map[string]bool{username: true}
+	for _, recipient := range recipients {
+		if seen[recipient] {
+			continue
+		}
+		seen[recipient] = true
+		if _, ok := tx.ActiveUser(recipient); !ok {
+			return dmConversation{}, errNoSuchUser
+		}
+		members = append(members, recipient)
+	}
+	if len(members) < 2 {
+		return dmConversation{}, errNoRecipients
+	}
+	if len(members) > maxConversationMembers {
+		return dmConversation{}, errTooManyMembers
+	}
+	sort.Strings(members)
+
+	var existing *dmConversation
+	tx.ForEachConversation(username, func(c dmConversation) error {
+		if strings.Join(c.Members, "\x00") == strings.Join(members, "\x00") {
+			existing = &c
+			return errStopIteration
+		}
+		return nil
+	})
+	if existing != nil {
+		return *existing, blocked(*existing)
+	}
+	c := dmConversation{ID: newID, Members: members}
+	if err := blocked(c); err != nil {
+		return dmConversation{}, err
+	}
+	for _, member := range members {
+		if member != username && !tx.AcceptsMessagesFrom(member, username) {
+			return dmConversation{}, errDMNotAllowed
+		}
+	}
+	return c, nil
+}
+
+//SendMessage adds a message of the user to the mailboxes of the conversation's members and returns the
+//conversation
+func (tx *Tx) SendMessage(username string, id int64, recipients []string, m directMessage) (dmConversation, error) {
+	if m.Text == "" {
+		return dmConversation{}, errEmptyMessage
+	}
+	c, err := tx.messageTarget(username, id, recipients, m.ID)
+	if err != nil {
+		return c, err
+	}
+	started := c.ID == m.ID
+	m.ConversationID = c.ID
+	m.Sender = username
+	c.LastMessage = m.ID
+	for _, member := range c.Members {
+		//members who deleted their account, or deleted it and registered again, do not get the message
+		if _, ok := tx.Conversation(member, c.ID); !ok && !started {
+			continue
+		}
+		if err := tx.putConversation(member, c); err != nil {
+			return c, err
+		}
+		if err := tx.putMessage(member, m); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+func messageToProto(m directMessage) *pb.DirectMessage {
+	return &pb.DirectMessage{Id: m.ID, ConversationId: m.ConversationID, Sender: m.Sender, Text: m.Text, Timestamp: m.Timestamp}
+}
+
+func protoToMessage(in *pb.DirectMessage) directMessage {
+	return directMessage{ID: in.Id, ConversationID: in.ConversationId, Sender: in.Sender, Text: in.Text, Timestamp: in.Timestamp}
+}
+
+func conversationToProto(c dmConversation) *pb.DMConversation {
+	return &pb.DMConversation{Id: c.ID, Members: c.Members, LastMessage: &pb.DirectMessage{Id: c.LastMessage}}
+}
+
+func protoToConversation(in *pb.DMConversation) dmConversation {
+	c := dmConversation{ID: in.Id, Members: in.Members}
+	if in.LastMessage != nil {
+		c.LastMessage = in.LastMessage.Id
+	}
+	return c
+}
+
+//SendMessage sends a direct message to a conversation, starting the conversation if needed
+func (s *server) SendMessage(ctx context.Context, in *pb.SendMessageRequest) (*pb.SendMessageReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Send Message operation, server is recovering")
+		return &pb.SendMessageReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//The message's ID and time are fixed before it is logged, like those of a tweet. A new conversation gets
+		//the ID of its first message, so neither ID is taken in any mailbox
+		in.Timestamp = nowMillis()
+
+		//A message which is not allowed is not logged. The conversation is looked up once, so the backups
+		//do not depend on the recipients any more
+		err := s.store.View(func(tx *Tx) error {
+			in.MessageId = tx.freeID(in.Timestamp, s.currentOp()+1, messageIDsBucket)
+			if in.Text == "" {
+				return errEmptyMessage
+			}
+			c, err := tx.messageTarget(in.Username, in.ConversationId, in.Recipients, in.MessageId)
+			in.ConversationId = c.ID
+			return err
+		})
+		if err != nil {
+			return &pb.SendMessageReply{Status: false}, err
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Send Message operation")
+			return &pb.SendMessageReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Send Message RPC calls to all the backup servers
+				_, err := rpccaller.SendMessage(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Message of %s replicated on Majority servers {Replication achieved} \n", in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Sending message on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	var c dmConversation
+	err := s.store.Update(func(tx *Tx) error {
+		var err error
+		m := directMessage{ID: in.MessageId, Text: in.Text, Timestamp: in.Timestamp}
+		c, err = tx.SendMessage(in.Username, in.ConversationId, in.Recipients, m)
+		return err
+	})
+	if err != nil {
+		fmt.Printf("Debug: Message of %s failed: %s \n", in.Username, err)
+		return &pb.SendMessageReply{Status: false}, err
+	}
+	return &pb.SendMessageReply{Status: true, ConversationId: c.ID, MessageId: in.MessageId}, nil
+}
+
+//ListConversations returns a page of the user's conversations, the one with the latest message first
+func (s *server) ListConversations(ctx context.Context, in *pb.ListConversationsRequest) (*pb.ListConversationsReply, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+	before := int64(-1)
+	if in.Cursor != "" {
+		id, err := decodeCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = id
+	}
+
+	response := &pb.ListConversationsReply{}
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.User(in.Username); !ok {
+			return errNoSuchUser
+		}
+		var conversations []dmConversation
+		tx.ForEachConversation(in.Username, func(c dmConversation) error {
+			if before < 0 || c.LastMessage < before {
+				conversations = append(conversations, c)
+			}
+			return nil
+		})
+		sort.Slice(conversations, func(i, j int) bool {
+			return conversations[i].LastMessage > conversations[j].LastMessage
+		})
+		if len(conversations) > limit {
+			conversations = conversations[:limit]
+			response.NextCursor = encodeCursor(conversations[limit-1].LastMessage)
+		}
+		for _, c := range conversations {
+			reply := conversationToProto(c)
+			var last directMessage
+			if tx.getJSON(messagesBucket, key(in.Username, tweetIDKey(c.ID), tweetIDKey(c.LastMessage)), &last) {
+				reply.LastMessage = messageToProto(last)
+			}
+			response.Conversations = append(response.Conversations, reply)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+//GetConversationMessages returns a page of the messages of one of the user's conversations, newest first
+func (s *server) GetConversationMessages(ctx context.Context, in *pb.ConversationMessagesRequest) (*pb.ConversationMessagesReply, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+	before := int64(-1)
+	if in.Cursor != "" {
+		id, err := decodeCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = id
+	}
+
+	response := &pb.ConversationMessagesReply{}
+	err := s.store.View(func(tx *Tx) error {
+		c, ok := tx.Conversation(in.Username, in.ConversationId)
+		if !ok {
+			return errNotMember
+		}
+		response.Members = c.Members
+		return tx.ForEachMessage(in.Username, c.ID, before, func(m directMessage) error {
+			if len(response.Messages) == limit {
+				response.NextCursor = encodeCursor(response.Messages[limit-1].Id)
+				return errStopIteration
+			}
+			response.Messages = append(response.Messages, messageToProto(m))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+//UpdateDMSettings sets whether users the user does not follow can start conversations with it
+func (s *server) UpdateDMSettings(ctx context.Context, in *pb.DMSettingsRequest) (*pb.DMSettingsReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Update DM Settings operation, server is recovering")
+		return &pb.DMSettingsReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Settings of an unknown user are not logged
+		var exists bool
+		s.store.View(func(tx *Tx) error {
+			_, exists = tx.ActiveUser(in.Username)
+			return nil
+		})
+		if !exists {
+			return &pb.DMSettingsReply{Status: false}, errNoSuchUser
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Update DM Settings operation")
+			return &pb.DMSettingsReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Update DM Settings RPC calls to all the backup servers
+				_, err := rpccaller.UpdateDMSettings(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: DM settings of %s replicated on Majority servers {Replication achieved} \n", in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Updating DM settings on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.SetOpenDMs(in.Username, in.OpenDms)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Updating DM settings of %s failed: %s \n", in.Username, err)
+		return &pb.DMSettingsReply{Status: false}, err
+	}
+	return &pb.DMSettingsReply{Status: true}, nil
+}
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Every user has an inbox of notifications about mentions, new followers, likes and replies. Notifications are
+//created when the operation causing them is applied, with an ID fixed by the primary so every server stores the
+//same inbox. Notifications about deleted tweets, or caused by deleted accounts or by users the owner of the inbox
+//blocked or muted, are skipped when the inbox is read. So are answered follow requests
+
+const maxNotifications = 1000 // the oldest notifications are dropped when an inbox grows beyond this
+
+const (
+	mentionNotification = "mention"
+	followNotification  = "follow"
+	likeNotification    = "like"
+	replyNotification   = "reply"
+)
+
+var mentionPattern = regexp.MustCompile(`@(\w+)`)
+
+type notification struct {
+	ID        int64
+	Kind      string // mention, follow, like, reply, follow_request or follow_approved
+	Actor     string // the user who caused the notification
+	TweetID   int64  // the tweet mentioning or answering the user, or the user's tweet which was liked
+	Timestamp int64
+}
+
+//mentions returns the users mentioned in a tweet, in the order they are first mentioned. Only active users
+//other than the author count as mentioned
+func (tx *Tx) mentions(author string, text string) []string {
+	var mentioned []string
+	seen := map[string]bool{author: true}
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		username := match[1]
+		if seen[username] {
+			continue
+		}
+		seen[username] = true
+		if _, ok := tx.ActiveUser(username); ok {
+			mentioned = append(mentioned, username)
+		}
+	}
+	return mentioned
+}
+
+//Notify adds a notification to the user's inbox. Users are not notified of their own actions
+func (tx *Tx) Notify(username string, n notification) error {
+	if username == n.Actor || n.ID == 0 {
+		return nil
+	}
+	if _, ok := tx.User(username); !ok {
+		return nil
+	}
+	if err := tx.putNotification(username, n); err != nil {
+		return err
+	}
+
+	//drop the oldest notifications of a full inbox
+	inbox := tx.notifications(username)
+	for len(inbox) > maxNotifications {
+		if err := tx.deleteNotification(username, inbox[0].ID); err != nil {
+			return err
+		}
+		inbox = inbox[1:]
+	}
+	return nil
+}
+
+//putNotification stores a notification as it is, a notification with the same ID is replaced
+func (tx *Tx) putNotification(username string, n notification) error {
+	if err := tx.kv.put(notificationIDsBucket, key(tweetIDKey(n.ID), username), []byte{}); err != nil {
+		return err
+	}
+	return tx.putJSON(notificationsBucket, tweetKey(username, n.ID), n)
+}
+
+func (tx *Tx) deleteNotification(username string, id int64) error {
+	if err := tx.kv.del(notificationIDsBucket, key(tweetIDKey(id), username)); err != nil {
+		return err
+	}
+	return tx.kv.del(notificationsBucket, tweetKey(username, id))
+}
+
+//deleteNotifications empties the user's inbox
+func (tx *Tx) deleteNotifications(username string) error {
+	for _, n := range tx.notifications(username) {
+		if err := tx.deleteNotification(username, n.ID); err != nil {
+			return err
+		}
+	}
+	return tx.kv.del(notificationsReadBucket, username)
+}
+
+//notifyMentions notifies the users mentioned in a new tweet
+func (tx *Tx) notifyMentions(t tweet) error {
+	for _, username := range t.Mentions {
+		n := notification{ID: t.ID, Kind: mentionNotification, Actor: t.Author, TweetID: t.ID, Timestamp: t.Timestamp}
+		if err := tx.Notify(username, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//notifications returns the user's notifications as they are stored, oldest first
+func (tx *Tx) notifications(username string) []notification {
+	var inbox []notification
+	tx.kv.forEach(notificationsBucket, key(username, ""), func(k string, v []byte) error {
+		var n notification
+		if err := json.Unmarshal(v, &n); err == nil {
+			inbox = append(inbox, n)
+		}
+		return nil
+	})
+	return inbox
+}
+
+//ForEachNotification calls fn for the user's notifications older than before, newest first. A negative
+//before starts with the newest notification
+func (tx *Tx) ForEachNotification(username string, before int64, fn func(n notification) error) error {
+	err := tx.kv.forEachReverse(notificationsBucket, key(username, ""), timelineKey(username, before), func(k string, v []byte) error {
+		var n notification
+		if err := json.Unmarshal(v, &n); err != nil {
+			return err
+		}
+		return fn(n)
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//visibleNotification reports whether a notification is shown to the user, and returns the tweet it is about
+func (tx *Tx) visibleNotification(username string, n notification) (tweet, bool) {
+	if _, ok := tx.ActiveUser(n.Actor); !ok {
+		return tweet{}, false
+	}
+	if tx.Hides(username, n.Actor) {
+		return tweet{}, false
+	}
+	if n.Kind == followRequestNotification && !tx.HasRequested(n.Actor, username) {
+		return tweet{}, false
+	}
+	if n.TweetID == 0 {
+		return tweet{}, true
+	}
+	t, ok := tx.TweetByID(n.TweetID)
+	return t, ok && !tx.HidesTweet(username, t)
+}
+
+//NotificationsRead returns the ID of the newest notification the user read, 0 if it read none
+func (tx *Tx) NotificationsRead(username string) int64 {
+	id, _ := strconv.ParseInt(string(tx.kv.get(notificationsReadBucket, username)), 10, 64)
+	return id
+}
+
+func (tx *Tx) putNotificationsRead(username string, id int64) error {
+	return tx.kv.put(notificationsReadBucket, username, []byte(strconv.FormatInt(id, 10)))
+}
+
+//MarkNotificationsRead marks the user's notifications up to the one with the given ID as read, or all of them
+//if upTo is 0. Notifications which were read are never marked unread again
+func (tx *Tx) MarkNotificationsRead(username string, upTo int64) error {
+	if _, ok := tx.User(username); !ok {
+		return errNoSuchUser
+	}
+	if upTo == 0 {
+		tx.ForEachNotification(username, -1, func(n notification) error {
+			upTo = n.ID
+			return errStopIteration
+		})
+	}
+	if upTo <= tx.NotificationsRead(username) {
+		return nil
+	}
+	return tx.putNotificationsRead(username, upTo)
+}
+
+//UnreadNotifications counts the user's shown notifications which were not read
+func (tx *Tx) UnreadNotifications(username string) int {
+	read := tx.NotificationsRead(username)
+	unread := 0
+	tx.ForEachNotification(username, -1, func(n notification) error {
+		if n.ID <= read {
+			return errStopIteration
+		}
+		if _, ok := tx.visibleNotification(username, n); ok {
+			unread++
+		}
+		return nil
+	})
+	return unread
+}
+
+//notificationToProto converts a stored notification into the message sent to clients and other servers
+func notificationToProto(n notification) *pb.Notification {
+	return &pb.Notification{Id: n.ID, Kind: n.Kind, Actor: n.Actor, TweetId: n.TweetID, Timestamp: n.Timestamp}
+}
+
+func protoToNotification(in *pb.Notification) notification {
+	return notification{ID: in.Id, Kind: in.Kind, Actor: in.Actor, TweetID: in.TweetId, Timestamp: in.Timestamp}
+}
+
+//ListNotifications returns a page of the user's notifications, newest first, with the number of unread ones
+func (s *server) ListNotifications(ctx context.Context, in *pb.NotificationsRequest) (*pb.NotificationsReply, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+	before := int64(-1)
+	if in.Cursor != "" {
+		id, err := decodeCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = id
+	}
+
+	response := &pb.NotificationsReply{}
+	var tweets []*pb.Tweet
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.User(in.Username); !ok {
+			return errNoSuchUser
+		}
+		read := tx.NotificationsRead(in.Username)
+		response.Unread = int32(tx.UnreadNotifications(in.Username))
+		return tx.ForEachNotification(in.Username, before, func(n notification) error {
+			t, ok := tx.visibleNotification(in.Username, n)
+			if !ok {
+				return nil
+			}
+			if len(response.Notifications) == limit {
+				response.NextCursor = encodeCursor(response.Notifications[limit-1].Id)
+				return errStopIteration
+			}
+			reply := notificationToProto(n)
+			reply.Read = n.ID <= read
+			if n.TweetID != 0 {
+				reply.Tweet = tweetToProto(t)
+				tweets = append(tweets, reply.Tweet)
+			}
+			response.Notifications = append(response.Notifications, reply)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.decorateTweets(in.Username, tweets)
+	return response, nil
+}
+
+//MarkNotificationsRead marks the user's notifications as read, up to a given notification or all of them
+func (s *server) MarkNotificationsRead(ctx context.Context, in *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Mark Notifications Read operation, server is recovering")
+		return &pb.MarkNotificationsReadReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Marking notifications of an unknown user fails everywhere, it is not logged
+		var exists bool
+		s.store.View(func(tx *Tx) error {
+			_, exists = tx.User(in.Username)
+			return nil
+		})
+		if !exists {
+			return &pb.MarkNotificationsReadReply{Status: false}, errNoSuchUser
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Mark Notifications Read operation")
+			return &pb.MarkNotificationsReadReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Mark Notifications Read RPC calls to all the backup servers
+				_, err := rpccaller.MarkNotificationsRead(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Notifications of %s marked read on Majority servers {Replication achieved} \n", in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Marking notifications read on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	var unread int
+	err := s.store.Update(func(tx *Tx) error {
+		if err := tx.MarkNotificationsRead(in.Username, in.UpTo); err != nil {
+			return err
+		}
+		unread = tx.UnreadNotifications(in.Username)
+		return nil
+	})
+	if err != nil {
+		fmt.Printf("Debug: Marking notifications of %s read failed: %s \n", in.Username, err)
+		return &pb.MarkNotificationsReadReply{Status: false}, err
+	}
+	return &pb.MarkNotificationsReadReply{Status: true, Unread: int32(unread)}, nil
+}
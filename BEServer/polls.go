@@ -0,0 +1,311 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//A poll is attached to a tweet when it is added. It closes at a fixed time after the tweet's creation; whether a
+//vote came in time is decided by the time the primary gave the vote before logging it, never by the time a
+//server applies it, so every server counts the same votes. The counts are stored with the tweet, the votes
+//are stored with the voter, like likes
+
+const (
+	minPollOptions      = 2
+	maxPollOptions      = 4
+	maxPollOptionLength = 25
+	minPollDuration     = time.Minute
+	maxPollDuration     = 7 * 24 * time.Hour
+)
+
+var errNoPoll = errors.New("tweet has no poll")
+var errPollClosed = errors.New("poll is closed")
+var errNoSuchOption = errors.New("no such poll option")
+var errAlreadyVoted = errors.New("user already voted in this poll")
+
+type poll struct {
+	Options   []pollOption
+	ExpiresAt int64 // votes logged at or after this time in unix milliseconds are rejected
+}
+
+type pollOption struct {
+	Text  string
+	Votes int
+}
+
+//pollVote is the option a user voted for in the poll of a tweet
+type pollVote struct {
+	TweetID int64
+	Option  int
+}
+
+func voteKey(id int64, username string) string {
+	return key(tweetIDKey(id), username)
+}
+
+//checkPoll returns an error unless the options and the duration in milliseconds make a valid poll
+func checkPoll(options []string, duration int64) error {
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return fmt.Errorf("a poll has %d to %d options", minPollOptions, maxPollOptions)
+	}
+	for _, option := range options {
+		if strings.TrimSpace(option) == "" {
+			return errors.New("poll options can not be empty")
+		}
+		if utf8.RuneCountInString(option) > maxPollOptionLength {
+			return fmt.Errorf("poll options are at most %d characters", maxPollOptionLength)
+		}
+	}
+	if duration < int64(minPollDuration/time.Millisecond) || duration > int64(maxPollDuration/time.Millisecond) {
+		return fmt.Errorf("a poll is open from %s to %s", minPollDuration, maxPollDuration)
+	}
+	return nil
+}
+
+//newPoll returns the poll of a tweet created at timestamp, nil if no options are given
+func newPoll(options []string, timestamp int64, duration int64) *poll {
+	if len(options) == 0 {
+		return nil
+	}
+	p := &poll{ExpiresAt: timestamp + duration}
+	for _, option := range options {
+		p.Options = append(p.Options, pollOption{Text: option})
+	}
+	return p
+}
+
+//Voted returns the option the user voted for in the poll of the tweet
+func (tx *Tx) Voted(username string, id int64) (int, bool) {
+	v := tx.kv.get(votedBucket, tweetKey(username, id))
+	if v == nil {
+		return 0, false
+	}
+	option, err := strconv.Atoi(string(v))
+	return option, err == nil
+}
+
+//checkVote returns the tweet carrying the poll, or the error a vote of the user logged at timestamp fails with
+func (tx *Tx) checkVote(username string, id int64, option int, timestamp int64) (tweet, error) {
+	if _, ok := tx.ActiveUser(username); !ok {
+		return tweet{}, errNoSuchUser
+	}
+	t, ok := tx.TweetByID(id)
+	if !ok {
+		return tweet{}, errNoSuchTweet
+	}
+	if t.Poll == nil {
+		return tweet{}, errNoPoll
+	}
+	if tx.Blocked(username, t.Author) {
+		return tweet{}, errBlocked
+	}
+	if !tx.CanView(username, t.Author) {
+		return tweet{}, errProtected
+	}
+	if timestamp >= t.Poll.ExpiresAt {
+		return tweet{}, errPollClosed
+	}
+	if option < 0 || option >= len(t.Poll.Options) {
+		return tweet{}, errNoSuchOption
+	}
+	if _, voted := tx.Voted(username, id); voted {
+		return tweet{}, errAlreadyVoted
+	}
+	return t, nil
+}
+
+//Vote records the user's vote for an option of the poll of the tweet and returns the poll. Every user votes once
+func (tx *Tx) Vote(username string, id int64, option int, timestamp int64) (*poll, error) {
+	t, err := tx.checkVote(username, id, option, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.putVote(username, id, option); err != nil {
+		return nil, err
+	}
+	t.Poll.Options[option].Votes++
+	return t.Poll, tx.putJSON(tweetsBucket, tweetKey(t.Author, id), t)
+}
+
+//putVote adds the vote edges only, the vote counts are stored with the tweets
+func (tx *Tx) putVote(username string, id int64, option int) error {
+	value := []byte(strconv.Itoa(option))
+	if err := tx.kv.put(votedBucket, tweetKey(username, id), value); err != nil {
+		return err
+	}
+	return tx.kv.put(votesBucket, voteKey(id, username), value)
+}
+
+//unvote removes the user's vote and its count, used when the user is purged
+func (tx *Tx) unvote(username string, id int64) error {
+	option, voted := tx.Voted(username, id)
+	if !voted {
+		return nil
+	}
+	if err := tx.kv.del(votedBucket, tweetKey(username, id)); err != nil {
+		return err
+	}
+	if err := tx.kv.del(votesBucket, voteKey(id, username)); err != nil {
+		return err
+	}
+	t, ok := tx.TweetByID(id)
+	if !ok || t.Poll == nil || option >= len(t.Poll.Options) {
+		return nil
+	}
+	t.Poll.Options[option].Votes--
+	return tx.putJSON(tweetsBucket, tweetKey(t.Author, id), t)
+}
+
+//votes returns the user's votes, oldest tweet first
+func (tx *Tx) votes(username string) []pollVote {
+	var votes []pollVote
+	prefix := key(username, "")
+	tx.kv.forEach(votedBucket, prefix, func(k string, v []byte) error {
+		id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64)
+		if err != nil {
+			return nil
+		}
+		if option, err := strconv.Atoi(string(v)); err == nil {
+			votes = append(votes, pollVote{TweetID: id, Option: option})
+		}
+		return nil
+	})
+	return votes
+}
+
+//deleteVotes removes all votes in the poll of a tweet which is deleted
+func (tx *Tx) deleteVotes(id int64) error {
+	prefix := key(tweetIDKey(id), "")
+	var voters []string
+	tx.kv.forEach(votesBucket, prefix, func(k string, v []byte) error {
+		voters = append(voters, strings.TrimPrefix(k, prefix))
+		return nil
+	})
+	for _, voter := range voters {
+		if err := tx.kv.del(votedBucket, tweetKey(voter, id)); err != nil {
+			return err
+		}
+	}
+	return tx.deletePrefix(votesBucket, prefix)
+}
+
+//decoratePoll completes the poll of a tweet returned to the user: whether it is closed and the user's vote
+func (tx *Tx) decoratePoll(username string, t *pb.Tweet) {
+	if t.Poll == nil {
+		return
+	}
+	t.Poll.Closed = nowMillis() >= t.Poll.ExpiresAt
+	if option, voted := tx.Voted(username, t.Id); voted {
+		t.Poll.Voted = true
+		t.Poll.Choice = int32(option)
+	}
+}
+
+func pollToProto(p *poll) *pb.Poll {
+	if p == nil {
+		return nil
+	}
+	reply := &pb.Poll{ExpiresAt: p.ExpiresAt}
+	for _, option := range p.Options {
+		reply.Options = append(reply.Options, &pb.PollOption{Text: option.Text, Votes: int32(option.Votes)})
+	}
+	return reply
+}
+
+func protoToPoll(p *pb.Poll) *poll {
+	if p == nil {
+		return nil
+	}
+	stored := &poll{ExpiresAt: p.ExpiresAt}
+	for _, option := range p.Options {
+		stored.Options = append(stored.Options, pollOption{Text: option.Text, Votes: int(option.Votes)})
+	}
+	return stored
+}
+
+//VotePoll records a user's vote for an option of the poll attached to a tweet
+func (s *server) VotePoll(ctx context.Context, in *pb.VotePollRequest) (*pb.VotePollReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Vote Poll operation, server is recovering")
+		return &pb.VotePollReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//The vote gets its time before it is logged, the servers decide with it whether the poll was still open
+		in.Timestamp = nowMillis()
+
+		//A vote which fails everywhere, a second one or one for a closed poll, is not logged
+		err := s.store.View(func(tx *Tx) error {
+			_, err := tx.checkVote(in.Username, in.TweetId, int(in.Option), in.Timestamp)
+			return err
+		})
+		if err != nil {
+			return &pb.VotePollReply{Status: false}, err
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Vote Poll operation")
+			return &pb.VotePollReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Vote Poll RPC calls to all the backup servers
+				_, err := rpccaller.VotePoll(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Vote of %s in poll %d replicated on Majority servers {Replication achieved} \n", in.Username, in.TweetId)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Voting on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	var result *pb.Poll
+	err := s.store.Update(func(tx *Tx) error {
+		p, err := tx.Vote(in.Username, in.TweetId, int(in.Option), in.Timestamp)
+		if err != nil {
+			return err
+		}
+		result = pollToProto(p)
+		result.Voted = true
+		result.Choice = in.Option
+		return nil
+	})
+	if err != nil {
+		fmt.Printf("Debug: Vote of %s in poll %d failed: %s \n", in.Username, in.TweetId, err)
+		return &pb.VotePollReply{Status: false}, err
+	}
+	return &pb.VotePollReply{Status: true, Poll: result}, nil
+}
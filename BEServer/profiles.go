@@ -0,0 +1,243 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//A profile is kept with the user's account. The avatar image is a blob uploaded before the profile is updated, the
+//account only keeps its ID
+
+const (
+	maxDisplayNameLength = 50
+	maxBioLength         = 160
+	maxLocationLength    = 30
+	maxWebsiteLength     = 100
+	maxAvatarSize        = 256 << 10 // avatars are shown small, larger images are not accepted
+)
+
+var errBadWebsite = errors.New("website has to be an http or https URL")
+var errNoSuchAvatar = errors.New("avatar was not uploaded")
+
+//checkProfile returns an error if a profile field is too long or the website is not a web URL
+func checkProfile(in *pb.UpdateProfileRequest) error {
+	for _, field := range []struct {
+		name  string
+		value string
+		limit int
+	}{{"display name", in.DisplayName, maxDisplayNameLength}, {"bio", in.Bio, maxBioLength},
+		{"location", in.Location, maxLocationLength}, {"website", in.Website, maxWebsiteLength}} {
+		if utf8.RuneCountInString(field.value) > field.limit {
+			return fmt.Errorf("%s is longer than %d characters", field.name, field.limit)
+		}
+	}
+	if in.Website != "" {
+		u, err := url.Parse(in.Website)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errBadWebsite
+		}
+	}
+	return nil
+}
+
+//UpdateProfile replaces the profile fields of the user. An empty avatar keeps the current one
+func (tx *Tx) UpdateProfile(username, displayName, bio, location, website, avatar string, removeAvatar bool) error {
+	user, ok := tx.ActiveUser(username)
+	if !ok {
+		return errNoSuchUser
+	}
+	user.DisplayName = displayName
+	user.Bio = bio
+	user.Location = location
+	user.Website = website
+	if removeAvatar {
+		user.Avatar = ""
+	}
+	if avatar != "" {
+		user.Avatar = avatar
+	}
+	return tx.PutUser(user)
+}
+
+//Profile returns the user's profile as seen by viewer
+func (tx *Tx) Profile(username, viewer string) (*pb.Profile, error) {
+	user, ok := tx.ActiveUser(username)
+	if !ok {
+		return nil, errNoSuchUser
+	}
+	profile := &pb.Profile{Username: user.Username, DisplayName: user.DisplayName, Bio: user.Bio, Location: user.Location,
+		Website: user.Website, Avatar: user.Avatar, Protected: user.Protected}
+	//deleted accounts are not counted, as in the follows lists
+	tx.ForEachFollower(username, func(follower string) error {
+		if _, ok := tx.ActiveUser(follower); ok {
+			profile.Followers++
+		}
+		return nil
+	})
+	tx.ForEachFollow(username, func(followed string) error {
+		if _, ok := tx.ActiveUser(followed); ok {
+			profile.Following++
+		}
+		return nil
+	})
+	tx.ForEachTweet(username, func(t tweet) error {
+		profile.Tweets++
+		return nil
+	})
+	if viewer != "" && viewer != username {
+		profile.Followed = tx.IsFollowing(viewer, username)
+		profile.Requested = tx.HasRequested(viewer, username)
+		profile.FollowsViewer = tx.IsFollowing(username, viewer)
+		profile.Blocked = tx.Blocked(viewer, username)
+	}
+	return profile, nil
+}
+
+//UpdateProfile replaces the user's display name, bio, location and website, and its avatar if a new one is given
+func (s *server) UpdateProfile(ctx context.Context, in *pb.UpdateProfileRequest) (*pb.UpdateProfileReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Update Profile operation, server is recovering")
+		return &pb.UpdateProfileReply{Status: false}, errors.New("server is recovering")
+	}
+	if err := checkProfile(in); err != nil {
+		return &pb.UpdateProfileReply{Status: false}, err
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Profiles of unknown users are not logged
+		var exists bool
+		s.store.View(func(tx *Tx) error {
+			_, exists = tx.ActiveUser(in.Username)
+			return nil
+		})
+		if !exists {
+			return &pb.UpdateProfileReply{Status: false}, errNoSuchUser
+		}
+		//The avatar has to be uploaded first, the operation only carries its blob ID
+		if in.Avatar != "" {
+			if _, err := s.blobs.Get(in.Avatar); err != nil {
+				return &pb.UpdateProfileReply{Status: false}, errNoSuchAvatar
+			}
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Update Profile operation")
+			return &pb.UpdateProfileReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Update Profile RPC calls to all the backup servers
+				_, err := rpccaller.UpdateProfile(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Profile of %s replicated on Majority servers {Replication achieved} \n", in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Updating profile on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.UpdateProfile(in.Username, in.DisplayName, in.Bio, in.Location, in.Website, in.Avatar, in.RemoveAvatar)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Updating profile of %s failed: %s \n", in.Username, err)
+		return &pb.UpdateProfileReply{Status: false}, err
+	}
+	return &pb.UpdateProfileReply{Status: true}, nil
+}
+
+//UploadAvatar stores an avatar image on every server and returns its blob ID, to be set with UpdateProfile. The
+//upload is not logged, blobs are named by their content so storing one twice changes nothing
+func (s *server) UploadAvatar(ctx context.Context, in *pb.UploadAvatarRequest) (*pb.UploadAvatarReply, error) {
+	if in.Broadcast {
+		var exists bool
+		s.store.View(func(tx *Tx) error {
+			_, exists = tx.ActiveUser(in.Username)
+			return nil
+		})
+		if !exists {
+			return nil, errNoSuchUser
+		}
+	}
+	if err := checkImage(in.Data, maxAvatarSize); err != nil {
+		return nil, err
+	}
+	id, err := s.blobs.Put(in.Data)
+	if err != nil {
+		fmt.Printf("Debug: Could not store avatar of %s: %s \n", in.Username, err)
+		return nil, err
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), uploadDeadline)
+				defer cancel()
+				//Upload Avatar RPC calls to all the backup servers
+				_, err := rpccaller.UploadAvatar(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					count++
+				}
+			}
+		}
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Avatar %s stored on Majority servers \n", id)
+		} else {
+			//servers missing the avatar fetch it from this one when it is asked for
+			fmt.Printf("Debug: Avatar %s stored only on %d servers \n", id, count+1)
+		}
+	}
+	return &pb.UploadAvatarReply{Id: id}, nil
+}
+
+//GetProfile returns a user's profile with its follower, following and tweet counts
+func (s *server) GetProfile(ctx context.Context, in *pb.ProfileRequest) (*pb.Profile, error) {
+	var profile *pb.Profile
+	err := s.store.View(func(tx *Tx) error {
+		var err error
+		profile, err = tx.Profile(in.Username, in.Viewer)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Only the approved followers of a protected account see its tweets. Following a protected account stores a
+//request which the account approves or rejects; until then the requester is treated like any other user who
+//does not follow it. Requests are stored with the requester, like follow edges, and indexed by the account
+
+const (
+	followRequestNotification  = "follow_request"
+	followApprovedNotification = "follow_approved"
+)
+
+var errProtected = errors.New("tweets of this account are protected")
+var errNoSuchRequest = errors.New("no such follow request")
+
+//CanView reports whether the user sees the tweets of author: author's account is not protected, or the user
+//follows it
+func (tx *Tx) CanView(username, author string) bool {
+	if username == author {
+		return true
+	}
+	user, ok := tx.User(author)
+	return !ok || !user.Protected || tx.IsFollowing(username, author)
+}
+
+//HasRequested reports whether the user asked to follow the protected account and was not answered yet
+func (tx *Tx) HasRequested(username, account string) bool {
+	return tx.kv.get(requestedBucket, key(username, account)) != nil
+}
+
+func (tx *Tx) putFollowRequest(username, account string) error {
+	if err := tx.kv.put(requestedBucket, key(username, account), []byte{}); err != nil {
+		return err
+	}
+	return tx.kv.put(followRequestsBucket, key(account, username), []byte{})
+}
+
+func (tx *Tx) deleteFollowRequest(username, account string) error {
+	if err := tx.kv.del(requestedBucket, key(username, account)); err != nil {
+		return err
+	}
+	return tx.kv.del(followRequestsBucket, key(account, username))
+}
+
+//ForEachRequested calls fn for every protected account the user asked to follow, in username order
+func (tx *Tx) ForEachRequested(username string, fn func(account string) error) error {
+	prefix := key(username, "")
+	return tx.kv.forEach(requestedBucket, prefix, func(k string, v []byte) error {
+		return fn(strings.TrimPrefix(k, prefix))
+	})
+}
+
+//ForEachFollowRequest calls fn for every user waiting for the account to approve its follow, in username order
+func (tx *Tx) ForEachFollowRequest(account string, fn func(requester string) error) error {
+	prefix := key(account, "")
+	return tx.kv.forEach(followRequestsBucket, prefix, func(k string, v []byte) error {
+		return fn(strings.TrimPrefix(k, prefix))
+	})
+}
+
+//requestsOf returns the pending follow requests of the account
+func (tx *Tx) requestsOf(account string) []string {
+	var requesters []string
+	tx.ForEachFollowRequest(account, func(requester string) error {
+		requesters = append(requesters, requester)
+		return nil
+	})
+	return requesters
+}
+
+//RequestFollow stores a request of the user to follow the protected account and notifies the account
+func (tx *Tx) RequestFollow(username, account string, n notification) error {
+	if tx.IsFollowing(username, account) || tx.HasRequested(username, account) {
+		return nil
+	}
+	if err := tx.putFollowRequest(username, account); err != nil {
+		return err
+	}
+	return tx.Notify(account, n)
+}
+
+//AnswerFollowRequest approves or rejects a pending request of requester to follow the account. An approved
+//requester follows the account and is notified
+func (tx *Tx) AnswerFollowRequest(account, requester string, approve bool, n notification) error {
+	if _, ok := tx.ActiveUser(account); !ok {
+		return errNoSuchUser
+	}
+	if !tx.HasRequested(requester, account) {
+		return errNoSuchRequest
+	}
+	if err := tx.deleteFollowRequest(requester, account); err != nil {
+		return err
+	}
+	if !approve {
+		return nil
+	}
+	if err := tx.Follow(requester, account); err != nil {
+		return err
+	}
+	return tx.Notify(requester, n)
+}
+
+//SetProtected sets whether only approved followers see the user's tweets. Unprotecting an account approves its
+//pending follow requests
+func (tx *Tx) SetProtected(username string, protected bool) error {
+	user, ok := tx.ActiveUser(username)
+	if !ok {
+		return errNoSuchUser
+	}
+	user.Protected = protected
+	if err := tx.PutUser(user); err != nil {
+		return err
+	}
+	if protected {
+		return nil
+	}
+	for _, requester := range tx.requestsOf(username) {
+		if err := tx.deleteFollowRequest(requester, username); err != nil {
+			return err
+		}
+		if err := tx.Follow(requester, username); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//deleteFollowRequests removes the follow requests the user sent. With purge set the requests sent to the user
+//are removed as well
+func (tx *Tx) deleteFollowRequests(username string, purge bool) error {
+	var accounts []string
+	tx.ForEachRequested(username, func(account string) error {
+		accounts = append(accounts, account)
+		return nil
+	})
+	for _, account := range accounts {
+		if err := tx.deleteFollowRequest(username, account); err != nil {
+			return err
+		}
+	}
+	if !purge {
+		return nil
+	}
+	for _, requester := range tx.requestsOf(username) {
+		if err := tx.deleteFollowRequest(requester, username); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//ApproveFollowRequest lets a user who asked to follow a protected account follow it
+func (s *server) ApproveFollowRequest(ctx context.Context, in *pb.FollowRequestDecision) (*pb.FollowRequestReply, error) {
+	return s.answerFollowRequest(in, true)
+}
+
+//RejectFollowRequest drops a request to follow a protected account, the requester is not notified
+func (s *server) RejectFollowRequest(ctx context.Context, in *pb.FollowRequestDecision) (*pb.FollowRequestReply, error) {
+	return s.answerFollowRequest(in, false)
+}
+
+//answerFollowRequest replicates and applies the approval or rejection of a follow request
+func (s *server) answerFollowRequest(in *pb.FollowRequestDecision, approve bool) (*pb.FollowRequestReply, error) {
+	operation := "Approve Follow Request"
+	if !approve {
+		operation = "Reject Follow Request"
+	}
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		fmt.Printf("Debug: Discarding %s operation, server is recovering \n", operation)
+		return &pb.FollowRequestReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Answering a request which is not pending fails everywhere, it is not logged
+		var pending bool
+		s.store.View(func(tx *Tx) error {
+			pending = tx.HasRequested(in.Requester, in.Username)
+			return nil
+		})
+		if !pending {
+			return &pb.FollowRequestReply{Status: false}, errNoSuchRequest
+		}
+
+		//The notification sent to an approved requester gets its ID and time before the operation is logged
+		in.Timestamp = nowMillis()
+		s.store.View(func(tx *Tx) error {
+			in.NotificationId = tx.freeID(in.Timestamp, s.currentOp()+1, notificationIDsBucket)
+			return nil
+		})
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			fmt.Printf("Debug: Discarding last %s operation \n", operation)
+			return &pb.FollowRequestReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				var err error
+				if approve {
+					_, err = rpccaller.ApproveFollowRequest(ctx, in)
+				} else {
+					_, err = rpccaller.RejectFollowRequest(ctx, in)
+				}
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: %s of %s by %s replicated on Majority servers {Replication achieved} \n", operation, in.Requester, in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: %s on all servers failed, applied only on %d servers", operation, count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		n := notification{ID: in.NotificationId, Kind: followApprovedNotification, Actor: in.Username, Timestamp: in.Timestamp}
+		return tx.AnswerFollowRequest(in.Username, in.Requester, approve, n)
+	})
+	if err != nil {
+		fmt.Printf("Debug: %s of %s by %s failed: %s \n", operation, in.Requester, in.Username, err)
+		return &pb.FollowRequestReply{Status: false}, err
+	}
+	return &pb.FollowRequestReply{Status: true}, nil
+}
+
+//ListFollowRequests returns a page of the users waiting for the user to approve their follow
+func (s *server) ListFollowRequests(ctx context.Context, in *pb.ListFollowsRequest) (*pb.ListFollowsResponse, error) {
+	return s.listFollows(in, func(tx *Tx, fn func(username string) error) error {
+		return tx.ForEachFollowRequest(in.Username, fn)
+	})
+}
+
+//SetProtected sets whether only approved followers see the user's tweets
+func (s *server) SetProtected(ctx context.Context, in *pb.ProtectRequest) (*pb.ProtectReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Set Protected operation, server is recovering")
+		return &pb.ProtectReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//Settings of an unknown user are not logged
+		var exists bool
+		s.store.View(func(tx *Tx) error {
+			_, exists = tx.ActiveUser(in.Username)
+			return nil
+		})
+		if !exists {
+			return &pb.ProtectReply{Status: false}, errNoSuchUser
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Set Protected operation")
+			return &pb.ProtectReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Set Protected RPC calls to all the backup servers
+				_, err := rpccaller.SetProtected(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Protection of %s replicated on Majority servers {Replication achieved} \n", in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Setting protection on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.SetProtected(in.Username, in.Protected)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Setting protection of %s failed: %s \n", in.Username, err)
+		return &pb.ProtectReply{Status: false}, err
+	}
+	return &pb.ProtectReply{Status: true}, nil
+}
@@ -0,0 +1,7 @@
+//go:build race
+// +build race
+
+package main
+
+//bolt 1.3.1 fails the pointer checks the race detector turns on, the store tests skip it when it runs
+const raceDetector = true
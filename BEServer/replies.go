@@ -0,0 +1,139 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//A reply is a tweet which references the tweet it answers, so the replies to a tweet form a tree. Replies are
+//kept when the tweet they answer is deleted, they can still be read as the conversation below the deleted tweet
+
+//replyTarget returns the tweet answered by a reply of the user to the tweet with the given ID. Replying to a
+//retweet answers the original tweet
+func (tx *Tx) replyTarget(username string, id int64) (tweet, error) {
+	parent, ok := tx.TweetByID(id)
+	if ok && parent.RetweetOf != 0 {
+		parent, ok = tx.TweetByID(parent.RetweetOf)
+	}
+	if !ok {
+		return tweet{}, errNoSuchTweet
+	}
+	if _, ok := tx.ActiveUser(parent.Author); !ok {
+		return tweet{}, errNoSuchTweet
+	}
+	if tx.Blocked(username, parent.Author) {
+		return tweet{}, errBlocked
+	}
+	if !tx.CanView(username, parent.Author) {
+		return tweet{}, errProtected
+	}
+	return parent, nil
+}
+
+//Reply adds a tweet of the user which answers the tweet t.ReplyTo, counts it with that tweet and notifies its
+//author
+func (tx *Tx) Reply(username string, t tweet) error {
+	if _, ok := tx.ActiveUser(username); !ok {
+		return errNoSuchUser
+	}
+	parent, err := tx.replyTarget(username, t.ReplyTo)
+	if err != nil {
+		return err
+	}
+	t.ReplyTo = parent.ID
+	parent.Replies++
+	if err := tx.putJSON(tweetsBucket, tweetKey(parent.Author, parent.ID), parent); err != nil {
+		return err
+	}
+	if err := tx.AddTweet(username, t); err != nil {
+		return err
+	}
+	//replaces the mention notification if the reply mentions the author as well
+	n := notification{ID: t.ID, Kind: replyNotification, Actor: username, TweetID: t.ID, Timestamp: t.Timestamp}
+	return tx.Notify(parent.Author, n)
+}
+
+//replyIDs returns the IDs of the direct replies to a tweet, oldest first
+func (tx *Tx) replyIDs(id int64) []int64 {
+	var ids []int64
+	prefix := key(tweetIDKey(id), "")
+	tx.kv.forEach(repliesBucket, prefix, func(k string, v []byte) error {
+		if reply, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64); err == nil {
+			ids = append(ids, reply)
+		}
+		return nil
+	})
+	return ids
+}
+
+//indexReply records a reply with the tweet it answers, the count is stored with that tweet
+func (tx *Tx) indexReply(t tweet) error {
+	if t.ReplyTo == 0 {
+		return nil
+	}
+	return tx.kv.put(repliesBucket, key(tweetIDKey(t.ReplyTo), tweetIDKey(t.ID)), []byte(t.Author))
+}
+
+func (tx *Tx) unindexReply(t tweet) error {
+	if t.ReplyTo == 0 {
+		return nil
+	}
+	return tx.kv.del(repliesBucket, key(tweetIDKey(t.ReplyTo), tweetIDKey(t.ID)))
+}
+
+//deleteReply is called before the tweet is deleted. It updates the reply count of the tweet it answers, the
+//replies to the tweet itself are kept
+func (tx *Tx) deleteReply(t tweet) error {
+	if t.ReplyTo == 0 {
+		return nil
+	}
+	if parent, ok := tx.TweetByID(t.ReplyTo); ok {
+		parent.Replies--
+		if err := tx.putJSON(tweetsBucket, tweetKey(parent.Author, parent.ID), parent); err != nil {
+			return err
+		}
+	}
+	return tx.unindexReply(t)
+}
+
+//GetConversation returns the tree of replies below a tweet. Tweets of deleted accounts, and a deleted tweet at
+//the root, are left out but their replies are not
+func (s *server) GetConversation(ctx context.Context, in *pb.ConversationRequest) (*pb.ConversationReply, error) {
+	var root *pb.ConversationNode
+	var tweets []*pb.Tweet
+	err := s.store.View(func(tx *Tx) error {
+		rootID := in.TweetId
+		if t, ok := tx.TweetByID(rootID); ok && t.RetweetOf != 0 {
+			rootID = t.RetweetOf
+		}
+		if _, ok := tx.TweetByID(rootID); !ok && len(tx.replyIDs(rootID)) == 0 {
+			return errNoSuchTweet
+		}
+		var build func(id int64) *pb.ConversationNode
+		build = func(id int64) *pb.ConversationNode {
+			node := &pb.ConversationNode{}
+			t, ok := tx.TweetByID(id)
+			//the replies below a tweet the user does not see are still shown
+			if _, active := tx.ActiveUser(t.Author); ok && active && !tx.HidesTweet(in.Username, t) {
+				node.Tweet = tweetToProto(t)
+				tweets = append(tweets, node.Tweet)
+			}
+			//replies are newer than the tweet they answer, so the tree has no cycles
+			for _, reply := range tx.replyIDs(id) {
+				node.Replies = append(node.Replies, build(reply))
+			}
+			return node
+		}
+		root = build(rootID)
+		return nil
+	})
+	if err != nil {
+		debugPrint("Debug: Conversation not found")
+		return &pb.ConversationReply{}, err
+	}
+	s.decorateTweets(in.Username, tweets)
+	return &pb.ConversationReply{Root: root}, nil
+}
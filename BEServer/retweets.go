@@ -0,0 +1,248 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//A retweet or a quote is a tweet of its own which references the original tweet. Retweets have no text and
+//go away with the original, quotes stay and show the original as unavailable.
+
+var errAlreadyRetweeted = errors.New("tweet is already retweeted")
+
+//originalID returns the ID of the retweeted or quoted tweet, 0 for a tweet which is neither
+func (t tweet) originalID() int64 {
+	if t.RetweetOf != 0 {
+		return t.RetweetOf
+	}
+	return t.QuoteOf
+}
+
+//HasRetweeted reports whether the user retweeted the tweet
+func (tx *Tx) HasRetweeted(username string, id int64) bool {
+	return tx.kv.get(retweetedBucket, tweetKey(username, id)) != nil
+}
+
+//retweetTarget returns the tweet the user retweets or quotes when it asks to share the tweet with the given ID.
+//Sharing a retweet shares the original tweet
+func (tx *Tx) retweetTarget(username string, id int64, quote bool) (tweet, error) {
+	if _, ok := tx.ActiveUser(username); !ok {
+		return tweet{}, errNoSuchUser
+	}
+	original, ok := tx.TweetByID(id)
+	if ok && original.RetweetOf != 0 {
+		original, ok = tx.TweetByID(original.RetweetOf)
+	}
+	if !ok {
+		return tweet{}, errNoSuchTweet
+	}
+	if _, ok := tx.ActiveUser(original.Author); !ok {
+		return tweet{}, errNoSuchTweet
+	}
+	if tx.Blocked(username, original.Author) {
+		return tweet{}, errBlocked
+	}
+	if !tx.CanView(username, original.Author) {
+		return tweet{}, errProtected
+	}
+	if !quote && tx.HasRetweeted(username, original.ID) {
+		return tweet{}, errAlreadyRetweeted
+	}
+	return original, nil
+}
+
+//Retweet adds a retweet of the tweet, or a quote of it if text is not empty, as a new tweet of the user
+func (tx *Tx) Retweet(username string, id int64, text string, newID int64, timestamp int64) error {
+	quote := text != ""
+	original, err := tx.retweetTarget(username, id, quote)
+	if err != nil {
+		return err
+	}
+	t := tweet{ID: newID, Text: text, Timestamp: timestamp}
+	if quote {
+		t.QuoteOf = original.ID
+		original.Quotes++
+	} else {
+		t.RetweetOf = original.ID
+		original.Retweets++
+	}
+	if err := tx.putJSON(tweetsBucket, tweetKey(original.Author, original.ID), original); err != nil {
+		return err
+	}
+	return tx.AddTweet(username, t)
+}
+
+//indexRetweet records a retweet or quote with its original tweet, the counts are stored with the original
+func (tx *Tx) indexRetweet(t tweet) error {
+	if t.RetweetOf != 0 {
+		if err := tx.kv.put(retweetedBucket, tweetKey(t.Author, t.RetweetOf), []byte{}); err != nil {
+			return err
+		}
+		return tx.kv.put(retweetsBucket, key(tweetIDKey(t.RetweetOf), tweetIDKey(t.ID)), []byte(t.Author))
+	}
+	if t.QuoteOf != 0 {
+		return tx.kv.put(retweetsBucket, key(tweetIDKey(t.QuoteOf), tweetIDKey(t.ID)), []byte(t.Author))
+	}
+	return nil
+}
+
+func (tx *Tx) unindexRetweet(t tweet) error {
+	if t.RetweetOf != 0 {
+		if err := tx.kv.del(retweetedBucket, tweetKey(t.Author, t.RetweetOf)); err != nil {
+			return err
+		}
+		return tx.kv.del(retweetsBucket, key(tweetIDKey(t.RetweetOf), tweetIDKey(t.ID)))
+	}
+	if t.QuoteOf != 0 {
+		return tx.kv.del(retweetsBucket, key(tweetIDKey(t.QuoteOf), tweetIDKey(t.ID)))
+	}
+	return nil
+}
+
+//deleteRetweets is called before the tweet is deleted. It updates the counts of the tweet's original if the
+//tweet is a retweet or quote, and deletes the retweets of the tweet
+func (tx *Tx) deleteRetweets(t tweet) error {
+	if original, ok := tx.TweetByID(t.originalID()); ok && t.originalID() != 0 {
+		if t.RetweetOf != 0 {
+			original.Retweets--
+		} else {
+			original.Quotes--
+		}
+		if err := tx.putJSON(tweetsBucket, tweetKey(original.Author, original.ID), original); err != nil {
+			return err
+		}
+	}
+	if err := tx.unindexRetweet(t); err != nil {
+		return err
+	}
+
+	type share struct {
+		id     int64
+		author string
+	}
+	var shares []share
+	prefix := key(tweetIDKey(t.ID), "")
+	tx.kv.forEach(retweetsBucket, prefix, func(k string, v []byte) error {
+		if id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64); err == nil {
+			shares = append(shares, share{id, string(v)})
+		}
+		return nil
+	})
+	for _, s := range shares {
+		if retweet, ok := tx.Tweet(s.author, s.id); ok && retweet.RetweetOf == t.ID {
+			if err := tx.DeleteTweet(s.author, s.id); err != nil {
+				return err
+			}
+		}
+	}
+	//quotes keep referencing the deleted tweet
+	return tx.deletePrefix(retweetsBucket, prefix)
+}
+
+//decorateTweets completes tweets returned to the user: whether the user liked them, and the retweeted or quoted
+//tweets. An original which was deleted, whose author's account was deleted, or which the user does not see is
+//left out
+func (s *server) decorateTweets(username string, tweets []*pb.Tweet) {
+	s.store.View(func(tx *Tx) error {
+		for _, t := range tweets {
+			t.Liked = tx.HasLiked(username, t.Id)
+			tx.decoratePoll(username, t)
+			originalID := t.RetweetOf
+			if originalID == 0 {
+				originalID = t.QuoteOf
+			}
+			if originalID == 0 {
+				continue
+			}
+			original, ok := tx.TweetByID(originalID)
+			if _, active := tx.ActiveUser(original.Author); ok && active && !tx.HidesTweet(username, original) {
+				t.Original = tweetToProto(original)
+				t.Original.Liked = tx.HasLiked(username, original.ID)
+				tx.decoratePoll(username, t.Original)
+			}
+		}
+		return nil
+	})
+}
+
+//Retweet shares a tweet with the user's followers, as a retweet or as a quote tweet if a text is given
+func (s *server) Retweet(ctx context.Context, in *pb.RetweetRequest) (*pb.RetweetReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Retweet operation, server is recovering")
+		return &pb.RetweetReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//An operation which fails everywhere is not logged
+		err := s.store.View(func(tx *Tx) error {
+			_, err := tx.retweetTarget(in.Username, in.TweetId, in.TweetText != "")
+			return err
+		})
+		if err != nil {
+			return &pb.RetweetReply{Status: false}, err
+		}
+
+		//The new tweet's ID and creation time are fixed before it is logged, like those of any other tweet
+		in.Timestamp = nowMillis()
+		s.store.View(func(tx *Tx) error {
+			in.NewTweetId = tx.freeID(in.Timestamp, s.currentOp()+1, tweetIDsBucket, notificationIDsBucket)
+			return nil
+		})
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Retweet operation")
+			return &pb.RetweetReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Retweet RPC calls to all the backup servers
+				_, err := rpccaller.Retweet(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Retweet of %d by %s replicated on Majority servers {Replication achieved} \n", in.TweetId, in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Retweet on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.Retweet(in.Username, in.TweetId, in.TweetText, in.NewTweetId, in.Timestamp)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Retweet of %d by %s failed: %s \n", in.TweetId, in.Username, err)
+		return &pb.RetweetReply{Status: false}, err
+	}
+	return &pb.RetweetReply{Status: true, TweetId: in.NewTweetId}, nil
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Scheduled tweets wait in a queue kept with the user until they are due. The primary publishes due tweets as
+//ordinary AddTweet operations which carry the ID of the scheduled tweet; applying the operation removes the
+//tweet from the queue on every server, and the primary does not log a tweet for a scheduled tweet which is no
+//longer queued. A primary taking over after a view change therefore only publishes what was not published yet
+
+const (
+	maxScheduledTweets = 100 // scheduled tweets queued per user
+	maxScheduleAhead   = 365 * 24 * time.Hour
+	scheduleInterval   = time.Second
+	maxPublishBackoff  = time.Minute // longest wait before a scheduled tweet which failed to publish is tried again
+	maxPublishAttempts = 10          // attempts to copy missing images before a scheduled tweet is dropped
+)
+
+var errNoSuchScheduledTweet = errors.New("no such scheduled tweet")
+var errTooManyScheduled = fmt.Errorf("at most %d tweets can be scheduled", maxScheduledTweets)
+
+type scheduledTweet struct {
+	ID           int64
+	Text         string
+	PublishAt    int64 // unix milliseconds
+	Media        []media
+	PollOptions  []string
+	PollDuration int64 // milliseconds, the poll opens when the tweet is published
+	CreatedAt    int64
+}
+
+//scheduleKey orders the queue of all users by publication time
+func scheduleKey(username string, st scheduledTweet) string {
+	return key(tweetIDKey(st.PublishAt), username, tweetIDKey(st.ID))
+}
+
+//ScheduledTweet returns one of the user's scheduled tweets
+func (tx *Tx) ScheduledTweet(username string, id int64) (scheduledTweet, bool) {
+	var st scheduledTweet
+	ok := tx.getJSON(scheduledBucket, tweetKey(username, id), &st)
+	return st, ok
+}
+
+//Schedule queues a tweet of the user
+func (tx *Tx) Schedule(username string, st scheduledTweet) error {
+	if _, ok := tx.ActiveUser(username); !ok {
+		return errNoSuchUser
+	}
+	return tx.putScheduled(username, st)
+}
+
+func (tx *Tx) putScheduled(username string, st scheduledTweet) error {
+	if err := tx.putJSON(scheduledBucket, tweetKey(username, st.ID), st); err != nil {
+		return err
+	}
+	if err := tx.kv.put(scheduledIDsBucket, tweetIDKey(st.ID), []byte(username)); err != nil {
+		return err
+	}
+	return tx.kv.put(scheduleBucket, scheduleKey(username, st), []byte{})
+}
+
+//CancelScheduled removes one of the user's scheduled tweets from the queue
+func (tx *Tx) CancelScheduled(username string, id int64) error {
+	if _, ok := tx.ScheduledTweet(username, id); !ok {
+		return errNoSuchScheduledTweet
+	}
+	return tx.deleteScheduled(username, id)
+}
+
+//deleteScheduled removes a scheduled tweet which was published or cancelled. Removing it twice changes nothing
+func (tx *Tx) deleteScheduled(username string, id int64) error {
+	st, ok := tx.ScheduledTweet(username, id)
+	if !ok {
+		return nil
+	}
+	if err := tx.kv.del(scheduleBucket, scheduleKey(username, st)); err != nil {
+		return err
+	}
+	if err := tx.kv.del(scheduledIDsBucket, tweetIDKey(id)); err != nil {
+		return err
+	}
+	return tx.kv.del(scheduledBucket, tweetKey(username, id))
+}
+
+//ForEachScheduled calls fn for the user's scheduled tweets in the order they were scheduled
+func (tx *Tx) ForEachScheduled(username string, fn func(st scheduledTweet) error) error {
+	return tx.kv.forEach(scheduledBucket, key(username, ""), func(k string, v []byte) error {
+		var st scheduledTweet
+		if err := json.Unmarshal(v, &st); err != nil {
+			return err
+		}
+		return fn(st)
+	})
+}
+
+//scheduledOf returns the user's scheduled tweets in the order they were scheduled
+func (tx *Tx) scheduledOf(username string) []scheduledTweet {
+	var queue []scheduledTweet
+	tx.ForEachScheduled(username, func(st scheduledTweet) error {
+		queue = append(queue, st)
+		return nil
+	})
+	return queue
+}
+
+//ForEachDue calls fn for the scheduled tweets of all users due at the given time, the earliest first
+func (tx *Tx) ForEachDue(now int64, fn func(username string, st scheduledTweet) error) error {
+	err := tx.kv.forEach(scheduleBucket, "", func(k string, v []byte) error {
+		parts := strings.Split(k, "\x00")
+		if len(parts) != 3 {
+			return nil
+		}
+		if parts[0] > tweetIDKey(now) {
+			return errStopIteration
+		}
+		id, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return nil
+		}
+		if st, ok := tx.ScheduledTweet(parts[1], id); ok {
+			return fn(parts[1], st)
+		}
+		return nil
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//deleteScheduledTweets removes all scheduled tweets of the user
+func (tx *Tx) deleteScheduledTweets(username string) error {
+	for _, st := range tx.scheduledOf(username) {
+		if err := tx.deleteScheduled(username, st.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scheduledToProto(st scheduledTweet) *pb.ScheduledTweet {
+	return &pb.ScheduledTweet{Id: st.ID, TweetText: st.Text, PublishAt: st.PublishAt, Media: mediaToProto(st.Media),
+		PollOptions: st.PollOptions, PollDuration: st.PollDuration, CreatedAt: st.CreatedAt}
+}
+
+func protoToScheduled(in *pb.ScheduledTweet) scheduledTweet {
+	return scheduledTweet{ID: in.Id, Text: in.TweetText, PublishAt: in.PublishAt, Media: protoToMedia(in.Media),
+		PollOptions: in.PollOptions, PollDuration: in.PollDuration, CreatedAt: in.CreatedAt}
+}
+
+//checkSchedule returns the error scheduling the tweet at the given time fails with
+func (s *server) checkSchedule(in *pb.ScheduleTweetRequest, now int64) error {
+	if in.PublishAt <= now {
+		return errors.New("scheduled time has to be in the future")
+	}
+	if in.PublishAt > now+int64(maxScheduleAhead/time.Millisecond) {
+		return fmt.Errorf("tweets can be scheduled at most %s ahead", maxScheduleAhead)
+	}
+	if err := s.checkAttachments(in.Media, in.PollOptions, in.PollDuration); err != nil {
+		return err
+	}
+	return s.store.View(func(tx *Tx) error {
+		if _, ok := tx.ActiveUser(in.Username); !ok {
+			return errNoSuchUser
+		}
+		if len(tx.scheduledOf(in.Username)) >= maxScheduledTweets {
+			return errTooManyScheduled
+		}
+		return nil
+	})
+}
+
+//ScheduleTweet queues a tweet to be published at a later time
+func (s *server) ScheduleTweet(ctx context.Context, in *pb.ScheduleTweetRequest) (*pb.ScheduleTweetReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Schedule Tweet operation, server is recovering")
+		return &pb.ScheduleTweetReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//The scheduled tweet's ID and time are fixed before it is logged, so every server queues the same tweet
+		in.Timestamp = nowMillis()
+		s.store.View(func(tx *Tx) error {
+			in.Id = tx.freeID(in.Timestamp, s.currentOp()+1, scheduledIDsBucket)
+			return nil
+		})
+		if err := s.checkSchedule(in, in.Timestamp); err != nil {
+			return &pb.ScheduleTweetReply{Status: false}, err
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Schedule Tweet operation")
+			return &pb.ScheduleTweetReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Schedule Tweet RPC calls to all the backup servers
+				_, err := rpccaller.ScheduleTweet(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Scheduled tweet of %s replicated on Majority servers {Replication achieved} \n", in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Scheduling tweet on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	st := scheduledTweet{ID: in.Id, Text: in.TweetText, PublishAt: in.PublishAt, Media: protoToMedia(in.Media),
+		PollOptions: in.PollOptions, PollDuration: in.PollDuration, CreatedAt: in.Timestamp}
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.Schedule(in.Username, st)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Scheduling tweet of %s failed: %s \n", in.Username, err)
+		return &pb.ScheduleTweetReply{Status: false}, err
+	}
+	return &pb.ScheduleTweetReply{Status: true, Id: in.Id}, nil
+}
+
+//CancelScheduledTweet removes a tweet from the user's queue before it is published
+func (s *server) CancelScheduledTweet(ctx context.Context, in *pb.CancelScheduledRequest) (*pb.CancelScheduledReply, error) {
+
+	//A recovering server gets its state from the primary, it does not apply operations
+	if _, status := s.viewStatus(); status == RECOVERING {
+		debugPrint("Debug: Discarding Cancel Scheduled Tweet operation, server is recovering")
+		return &pb.CancelScheduledReply{Status: false}, errors.New("server is recovering")
+	}
+
+	// Will be Broadcasted to all the other servers
+	if in.Broadcast == true {
+		s.opMu.Lock()
+		defer s.opMu.Unlock()
+
+		//A tweet which was already published or cancelled can not be cancelled, this is not logged
+		var queued bool
+		s.store.View(func(tx *Tx) error {
+			_, queued = tx.ScheduledTweet(in.Username, in.Id)
+			return nil
+		})
+		if !queued {
+			return &pb.CancelScheduledReply{Status: false}, errNoSuchScheduledTweet
+		}
+
+		//Starting Prepare
+		index, _, ok := s.Start(in.String())
+		if ok == false {
+			debugPrint("Error: Discarding last Cancel Scheduled Tweet operation")
+			return &pb.CancelScheduledReply{Status: false}, errors.New("backend replication system down")
+		}
+
+		//Majority servers agreed in the prepare phase
+		//Setting broadcast false so that the backup servers don't send this message further
+		in.Broadcast = false
+		count := 0
+		for i, rpccaller := range s.peerRPC {
+			if i != s.me {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				defer cancel()
+				//Cancel Scheduled Tweet RPC calls to all the backup servers
+				_, err := rpccaller.CancelScheduledTweet(ctx, in)
+				if err != nil {
+					fmt.Printf("Debug: Server %d was unreachable \n", i)
+				} else {
+					//Counting the number of successful commits
+					count++
+				}
+			}
+		}
+
+		//Majority of backups successfully performed the operation
+		if count >= len(s.peers)/2 {
+			fmt.Printf("Debug: Cancelling scheduled tweet %d of %s replicated on Majority servers {Replication achieved} \n", in.Id, in.Username)
+			s.setCommitIndex(index)
+		} else {
+			//RPC to majority servers failed
+			fmt.Printf("Debug: Cancelling scheduled tweet on all servers failed, applied only on %d servers", count+1)
+		}
+	}
+
+	err := s.store.Update(func(tx *Tx) error {
+		return tx.CancelScheduled(in.Username, in.Id)
+	})
+	if err != nil {
+		fmt.Printf("Debug: Cancelling scheduled tweet %d of %s failed: %s \n", in.Id, in.Username, err)
+		return &pb.CancelScheduledReply{Status: false}, err
+	}
+	return &pb.CancelScheduledReply{Status: true}, nil
+}
+
+//ListScheduledTweets returns the user's queue, the next tweet to be published first
+func (s *server) ListScheduledTweets(ctx context.Context, in *pb.ListScheduledRequest) (*pb.ListScheduledReply, error) {
+	reply := &pb.ListScheduledReply{}
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.User(in.Username); !ok {
+			return errNoSuchUser
+		}
+		for _, st := range tx.scheduledOf(in.Username) {
+			reply.Scheduled = append(reply.Scheduled, scheduledToProto(st))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(reply.Scheduled, func(i, j int) bool {
+		return reply.Scheduled[i].PublishAt < reply.Scheduled[j].PublishAt
+	})
+	return reply, nil
+}
+
+//publishScheduledTweets runs in the background on every server, the primary publishes the tweets which are due.
+//A tweet which can never be published, because its poll or its images are not valid any more, is dropped from the
+//queue with a replicated cancel. Other failures are retried with a growing wait, an image the primary misses is
+//tried to be copied from the peers maxPublishAttempts times
+func (srv *server) publishScheduledTweets() {
+	type retry struct {
+		attempts int
+		at       int64 // unix milliseconds before which the tweet is not tried again
+	}
+	retries := make(map[int64]retry)
+	for {
+		time.Sleep(scheduleInterval)
+		view, status := srv.viewStatus()
+		if status != NORMAL || GetPrimary(view, len(srv.peers)) != srv.me {
+			continue
+		}
+		type due struct {
+			username string
+			st       scheduledTweet
+		}
+		var queue []due
+		now := nowMillis()
+		srv.store.View(func(tx *Tx) error {
+			return tx.ForEachDue(now, func(username string, st scheduledTweet) error {
+				//tweets of deleted accounts are published once the accounts are restored
+				if _, ok := tx.ActiveUser(username); ok {
+					queue = append(queue, due{username, st})
+				}
+				return nil
+			})
+		})
+		//tweets which left the queue are not retried
+		waiting := make(map[int64]retry)
+		for _, item := range queue {
+			if r, ok := retries[item.st.ID]; ok {
+				waiting[item.st.ID] = r
+			}
+		}
+		retries = waiting
+
+		//Publishing is a replicated AddTweet like any other tweet
+		for _, item := range queue {
+			r := retries[item.st.ID]
+			if now < r.at {
+				continue
+			}
+			fmt.Printf("Debug: Publishing scheduled tweet %d of %s \n", item.st.ID, item.username)
+			//a primary which missed the upload of an attached image copies it from its peers first
+			for _, m := range item.st.Media {
+				for _, id := range []string{m.ID, m.Thumbnail} {
+					if _, err := srv.blobs.Get(id); err == errNoSuchBlob {
+						srv.fetchBlob(id)
+					}
+				}
+			}
+			request := &pb.AddTweetRequest{Username: item.username, TweetText: item.st.Text, Media: mediaToProto(item.st.Media),
+				PollOptions: item.st.PollOptions, PollDuration: item.st.PollDuration, ScheduledId: item.st.ID, Broadcast: true}
+			err := srv.checkAttachments(request.Media, request.PollOptions, request.PollDuration)
+			if err == nil {
+				_, err = srv.AddTweet(context.Background(), request)
+				if err == nil || err == errNoSuchScheduledTweet {
+					delete(retries, item.st.ID)
+					continue
+				}
+			} else if err != errNoSuchMedia || r.attempts+1 >= maxPublishAttempts {
+				fmt.Printf("Debug: Dropping scheduled tweet %d of %s which can not be published: %s \n", item.st.ID, item.username, err)
+				_, err = srv.CancelScheduledTweet(context.Background(), &pb.CancelScheduledRequest{Username: item.username,
+					Id: item.st.ID, Broadcast: true})
+				if err == nil || err == errNoSuchScheduledTweet {
+					delete(retries, item.st.ID)
+					continue
+				}
+			}
+			r.attempts++
+			backoff := scheduleInterval << uint(r.attempts)
+			if backoff > maxPublishBackoff || backoff <= 0 {
+				backoff = maxPublishBackoff
+			}
+			r.at = now + int64(backoff/time.Millisecond)
+			retries[item.st.ID] = r
+			fmt.Printf("Debug: Publishing scheduled tweet %d of %s failed, retrying in %s: %s \n", item.st.ID, item.username,
+				backoff, err)
+		}
+	}
+}
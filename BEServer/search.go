@@ -0,0 +1,214 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Tweet text is kept in an inverted index on every server: for each word, the tweets using it and the positions
+//of the word in their text. It is updated with the tweets, so a server which installs state from another one
+//rebuilds it as it stores the tweets
+
+const maxSearchLimit = 100
+
+var errEmptyQuery = errors.New("search query has no words")
+
+//tokenize splits a text into lower case words
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+}
+
+func (tx *Tx) indexText(t tweet) error {
+	positions := map[string][]string{}
+	for i, term := range tokenize(t.Text) {
+		positions[term] = append(positions[term], strconv.Itoa(i))
+	}
+	for term, at := range positions {
+		if err := tx.kv.put(termsBucket, key(term, tweetIDKey(t.ID)), []byte(strings.Join(at, ","))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *Tx) unindexText(t tweet) error {
+	for _, term := range tokenize(t.Text) {
+		if err := tx.kv.del(termsBucket, key(term, tweetIDKey(t.ID))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//postings returns the tweets using a word and the positions of the word in each of them. With prefix set all
+//words starting with term are looked up
+func (tx *Tx) postings(term string, prefix bool) map[int64][]int {
+	found := map[int64][]int{}
+	scan := key(term, "")
+	if prefix {
+		scan = term
+	}
+	tx.kv.forEach(termsBucket, scan, func(k string, v []byte) error {
+		i := strings.LastIndex(k, "\x00")
+		id, err := strconv.ParseInt(k[i+1:], 10, 64)
+		if err != nil {
+			return nil
+		}
+		for _, at := range strings.Split(string(v), ",") {
+			if position, err := strconv.Atoi(at); err == nil {
+				found[id] = append(found[id], position)
+			}
+		}
+		return nil
+	})
+	return found
+}
+
+//A searchClause is a word or a phrase a tweet has to contain. The last word of a prefix clause only has to
+//start the word in the tweet
+type searchClause struct {
+	terms  []string
+	prefix bool
+}
+
+//parseQuery splits a query into clauses: "quoted phrases", words, and words ending with * which match any
+//word they start
+func parseQuery(query string) []searchClause {
+	var clauses []searchClause
+	for i, part := range strings.Split(query, `"`) {
+		if i%2 == 1 {
+			if terms := tokenize(part); len(terms) != 0 {
+				clauses = append(clauses, searchClause{terms: terms})
+			}
+			continue
+		}
+		for _, field := range strings.Fields(part) {
+			prefix := strings.HasSuffix(field, "*")
+			//a word like "don't" is split up and has to match as a phrase
+			if terms := tokenize(strings.TrimSuffix(field, "*")); len(terms) != 0 {
+				clauses = append(clauses, searchClause{terms: terms, prefix: prefix})
+			}
+		}
+	}
+	return clauses
+}
+
+//match returns how often the clause occurs in each tweet containing it
+func (tx *Tx) match(clause searchClause) map[int64]int {
+	lists := make([]map[int64][]int, len(clause.terms))
+	for i, term := range clause.terms {
+		lists[i] = tx.postings(term, clause.prefix && i == len(clause.terms)-1)
+	}
+	matches := map[int64]int{}
+	for id, first := range lists[0] {
+		for _, start := range first {
+			found := true
+			for i := 1; i < len(lists) && found; i++ {
+				found = false
+				for _, position := range lists[i][id] {
+					if position == start+i {
+						found = true
+						break
+					}
+				}
+			}
+			if found {
+				matches[id]++
+			}
+		}
+	}
+	return matches
+}
+
+//Search returns the IDs of the tweets containing every clause, best match first. A clause found in few tweets
+//weighs more than a common one, and newer tweets come first among equal matches
+func (tx *Tx) Search(clauses []searchClause, keep func(t tweet) bool) []int64 {
+	scores := map[int64]float64{}
+	for i, clause := range clauses {
+		matches := tx.match(clause)
+		weight := 1 / math.Log(2+float64(len(matches)))
+		next := map[int64]float64{}
+		for id, count := range matches {
+			score, ok := scores[id]
+			if i != 0 && !ok {
+				continue
+			}
+			next[id] = score + (1+math.Log(float64(count)))*weight
+		}
+		scores = next
+	}
+
+	var ids []int64
+	for id := range scores {
+		if t, ok := tx.TweetByID(id); ok && keep(t) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] > ids[j]
+	})
+	return ids
+}
+
+//SearchTweets returns a page of the tweets matching a query and the filters, best match first
+func (s *server) SearchTweets(ctx context.Context, in *pb.SearchRequest) (*pb.SearchReply, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	//a cursor is the number of matches on the previous pages
+	offset := int64(0)
+	if in.Cursor != "" {
+		var err error
+		if offset, err = decodeCursor(in.Cursor); err != nil || offset < 0 {
+			return nil, errBadCursor
+		}
+	}
+	clauses := parseQuery(in.Query)
+	if len(clauses) == 0 {
+		return nil, errEmptyQuery
+	}
+
+	response := &pb.SearchReply{}
+	s.store.View(func(tx *Tx) error {
+		ids := tx.Search(clauses, func(t tweet) bool {
+			if in.Author != "" && t.Author != in.Author {
+				return false
+			}
+			if (in.Since != 0 && t.Timestamp < in.Since) || (in.Until != 0 && t.Timestamp >= in.Until) {
+				return false
+			}
+			if tx.HidesTweet(in.Username, t) {
+				return false
+			}
+			//Tweets of deleted accounts are hidden until the accounts are restored
+			_, ok := tx.ActiveUser(t.Author)
+			return ok
+		})
+		response.Total = int32(len(ids))
+		for i := int(offset); i < len(ids) && i < int(offset)+limit; i++ {
+			t, _ := tx.TweetByID(ids[i])
+			response.Tweets = append(response.Tweets, tweetToProto(t))
+		}
+		if int(offset)+limit < len(ids) {
+			response.NextCursor = encodeCursor(offset + int64(limit))
+		}
+		return nil
+	})
+	s.decorateTweets(in.Username, response.Tweets)
+	return response, nil
+}
@@ -0,0 +1,421 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//The stress test runs a cluster of three servers on the memory store in this process and calls every RPC from
+//many goroutines at once, on the primary and on the backups. Run it with go test -race: besides the race
+//detector it checks that the state of every server is consistent and that all servers hold the same state
+
+const (
+	stressUsers      = 8  // users of the cluster driven by their own goroutines, user<stressUsers> is purged during the test
+	stressOperations = 60 // operations every goroutine performs
+)
+
+//startCluster starts three servers on local ports and returns them with a client of each. stop shuts them down
+func startCluster(t *testing.T) (servers []*server, clients []pb.GreeterClient, stop func()) {
+	dir, err := ioutil.TempDir("", "stress")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var listeners []net.Listener
+	var peers []string
+	for i := 0; i < 3; i++ {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		listeners = append(listeners, lis)
+		peers = append(peers, lis.Addr().String())
+	}
+
+	var grpcServers []*grpc.Server
+	var conns []*grpc.ClientConn
+	for i, lis := range listeners {
+		srv := &server{me: i, status: NORMAL, peers: peers, stateFile: filepath.Join(dir, fmt.Sprintf("replica%d.state", i))}
+		srv.log = append(srv.log, &pb.LogEntry{})
+		srv.store, _ = openStore("memory", "")
+		srv.store.Update(func(tx *Tx) error {
+			return tx.Reset()
+		})
+		if srv.blobs, err = openBlobStore(filepath.Join(dir, fmt.Sprintf("replica%d.blobs", i))); err != nil {
+			t.Fatal(err)
+		}
+		s := grpc.NewServer(grpc.UnaryInterceptor(srv.applyInterceptor))
+		pb.RegisterGreeterServer(s, srv)
+		go s.Serve(lis)
+		grpcServers = append(grpcServers, s)
+		servers = append(servers, srv)
+	}
+	for _, port := range peers {
+		conn, err := grpc.Dial(port, grpc.WithInsecure())
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns = append(conns, conn)
+		clients = append(clients, pb.NewGreeterClient(conn))
+	}
+	for _, srv := range servers {
+		copy(srv.peerRPC[:], clients)
+	}
+
+	stop = func() {
+		for _, conn := range conns {
+			conn.Close()
+		}
+		for i, s := range grpcServers {
+			s.Stop()
+			servers[i].store.Close()
+		}
+		os.RemoveAll(dir)
+	}
+	return servers, clients, stop
+}
+
+//tweetIDs collects the IDs of the tweets and polls created during the test, shared by all goroutines
+type tweetIDs struct {
+	mu     sync.Mutex
+	tweets []int64
+	polls  []int64
+}
+
+func (ids *tweetIDs) add(id int64, poll bool) {
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+	ids.tweets = append(ids.tweets, id)
+	if poll {
+		ids.polls = append(ids.polls, id)
+	}
+}
+
+//pick returns a random tweet or poll ID, 0 if there is none yet
+func (ids *tweetIDs) pick(r *rand.Rand, poll bool) int64 {
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+	list := ids.tweets
+	if poll {
+		list = ids.polls
+	}
+	if len(list) == 0 {
+		return 0
+	}
+	return list[r.Intn(len(list))]
+}
+
+func stressUser(i int) string {
+	return fmt.Sprintf("user%d", i)
+}
+
+//stress performs random operations of one user. Writes go to the primary, reads to any server. Operations may
+//fail, e.g. liking a deleted tweet; only the state they leave behind is checked
+func stress(t *testing.T, w int, servers []*server, clients []pb.GreeterClient, ids *tweetIDs) {
+	r := rand.New(rand.NewSource(int64(w)))
+	primary := clients[0]
+	u := stressUser(w)
+	var own []int64
+	var lists []int64
+	var scheduled []int64
+
+	for i := 0; i < stressOperations; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		v := stressUser(r.Intn(stressUsers + 1))
+		id := ids.pick(r, false)
+		if w == 0 && i == stressOperations/2 {
+			//the extra account is purged while the others still interact with it
+			if _, err := primary.DeleteUser(ctx, &pb.Credentials{Uname: stressUser(stressUsers), Purge: true, Broadcast: true}); err != nil {
+				t.Errorf("purge of %s failed: %v", stressUser(stressUsers), err)
+			}
+		}
+		switch r.Intn(20) {
+		case 0:
+			reply, err := primary.AddTweet(ctx, &pb.AddTweetRequest{Username: u, TweetText: fmt.Sprintf("tweet %d #stress @%s", i, v),
+				Broadcast: true})
+			if err == nil {
+				own = append(own, reply.TweetId)
+				ids.add(reply.TweetId, false)
+			}
+		case 1:
+			reply, err := primary.AddTweet(ctx, &pb.AddTweetRequest{Username: u, TweetText: "poll", PollOptions: []string{"yes", "no"},
+				PollDuration: int64(time.Hour / time.Millisecond), Broadcast: true})
+			if err == nil {
+				own = append(own, reply.TweetId)
+				ids.add(reply.TweetId, true)
+			}
+		case 2:
+			primary.FollowUser(ctx, &pb.FollowUserRequest{SelfUsername: u, ToFollowUsername: v, Broadcast: true})
+		case 3:
+			primary.UnfollowUser(ctx, &pb.UnfollowUserRequest{SelfUsername: u, ToUnfollowUsername: v, Broadcast: true})
+		case 4:
+			primary.LikeTweet(ctx, &pb.LikeRequest{Username: u, TweetId: id, Broadcast: true})
+		case 5:
+			primary.UnlikeTweet(ctx, &pb.LikeRequest{Username: u, TweetId: id, Broadcast: true})
+		case 6:
+			reply, err := primary.Retweet(ctx, &pb.RetweetRequest{Username: u, TweetId: id, TweetText: []string{"", "quote"}[r.Intn(2)],
+				Broadcast: true})
+			if err == nil {
+				own = append(own, reply.TweetId)
+			}
+			primary.AddTweet(ctx, &pb.AddTweetRequest{Username: u, TweetText: "reply", ReplyTo: id, Broadcast: true})
+		case 7:
+			if len(own) > 0 {
+				primary.DeleteTweet(ctx, &pb.DeleteTweetRequest{Username: u, TweetId: own[r.Intn(len(own))], Broadcast: true})
+			}
+		case 8:
+			if len(own) > 0 {
+				primary.EditTweet(ctx, &pb.EditTweetRequest{Username: u, TweetId: own[r.Intn(len(own))], TweetText: "edited #stress",
+					Broadcast: true})
+			}
+		case 9:
+			primary.VotePoll(ctx, &pb.VotePollRequest{Username: u, TweetId: ids.pick(r, true), Option: int32(r.Intn(2)), Broadcast: true})
+		case 10:
+			primary.SendMessage(ctx, &pb.SendMessageRequest{Username: u, Recipients: []string{v}, Text: "hi", Broadcast: true})
+			primary.UpdateDMSettings(ctx, &pb.DMSettingsRequest{Username: u, OpenDms: r.Intn(2) == 0, Broadcast: true})
+		case 11:
+			if r.Intn(2) == 0 {
+				primary.BlockUser(ctx, &pb.BlockRequest{Username: u, Target: v, Broadcast: true})
+			} else {
+				primary.UnblockUser(ctx, &pb.BlockRequest{Username: u, Target: v, Broadcast: true})
+			}
+		case 12:
+			if r.Intn(2) == 0 {
+				primary.MuteUser(ctx, &pb.BlockRequest{Username: u, Target: v, Broadcast: true})
+			} else {
+				primary.UnmuteUser(ctx, &pb.BlockRequest{Username: u, Target: v, Broadcast: true})
+			}
+		case 13:
+			primary.SetProtected(ctx, &pb.ProtectRequest{Username: u, Protected: r.Intn(2) == 0, Broadcast: true})
+			if requests, err := primary.ListFollowRequests(ctx, &pb.ListFollowsRequest{Username: u}); err == nil {
+				for _, requester := range requests.Users {
+					if r.Intn(2) == 0 {
+						primary.ApproveFollowRequest(ctx, &pb.FollowRequestDecision{Username: u, Requester: requester.Username, Broadcast: true})
+					} else {
+						primary.RejectFollowRequest(ctx, &pb.FollowRequestDecision{Username: u, Requester: requester.Username, Broadcast: true})
+					}
+				}
+			}
+		case 14:
+			if len(lists) == 0 || r.Intn(4) == 0 {
+				if reply, err := primary.CreateList(ctx, &pb.CreateListRequest{Username: u, Name: "list", Broadcast: true}); err == nil {
+					lists = append(lists, reply.ListId)
+				}
+				break
+			}
+			list := lists[r.Intn(len(lists))]
+			switch r.Intn(4) {
+			case 0:
+				primary.DeleteList(ctx, &pb.DeleteListRequest{Username: u, ListId: list, Broadcast: true})
+			case 1:
+				primary.RemoveListMember(ctx, &pb.ListMemberRequest{Username: u, ListId: list, Member: v, Broadcast: true})
+			default:
+				primary.AddListMember(ctx, &pb.ListMemberRequest{Username: u, ListId: list, Member: v, Broadcast: true})
+			}
+		case 15:
+			if len(scheduled) > 0 && r.Intn(2) == 0 {
+				primary.CancelScheduledTweet(ctx, &pb.CancelScheduledRequest{Username: u, Id: scheduled[0], Broadcast: true})
+				scheduled = scheduled[1:]
+				break
+			}
+			reply, err := primary.ScheduleTweet(ctx, &pb.ScheduleTweetRequest{Username: u, TweetText: "later",
+				PublishAt: nowMillis() + int64(time.Hour/time.Millisecond), Broadcast: true})
+			if err == nil {
+				scheduled = append(scheduled, reply.Id)
+			}
+		case 16:
+			primary.UpdateProfile(ctx, &pb.UpdateProfileRequest{Username: u, DisplayName: fmt.Sprintf("User %d", i),
+				Bio: "stress", Broadcast: true})
+			primary.MarkNotificationsRead(ctx, &pb.MarkNotificationsReadRequest{Username: u, Broadcast: true})
+		case 17:
+			//every user deletes and restores its account once in a while
+			if r.Intn(4) == 0 {
+				primary.DeleteUser(ctx, &pb.Credentials{Uname: u, Broadcast: true})
+				primary.RestoreUser(ctx, &pb.Credentials{Uname: u, Pwd: "password", Broadcast: true})
+			}
+		case 18:
+			//anti-entropy compares and repairs while operations are applied
+			servers[1+r.Intn(2)].antiEntropyRound()
+		default:
+			c := clients[r.Intn(len(clients))]
+			c.Login(ctx, &pb.Credentials{Uname: u, Pwd: "password"})
+			c.UserExists(ctx, &pb.UserExistsRequest{Username: v})
+			c.OwnTweets(ctx, &pb.OwnTweetsRequest{Username: v, Viewer: u})
+			c.UsersToFollow(ctx, &pb.UsersToFollowRequest{Username: u})
+			c.GetFriendsTweets(ctx, &pb.GetFriendsTweetsRequest{Username: u})
+			c.HomeTimeline(ctx, &pb.HomeTimelineRequest{Username: u})
+			c.ListLikedTweets(ctx, &pb.HomeTimelineRequest{Username: u})
+			c.ListNotifications(ctx, &pb.NotificationsRequest{Username: u})
+			c.ListFollowing(ctx, &pb.ListFollowsRequest{Username: v})
+			c.ListFollowers(ctx, &pb.ListFollowsRequest{Username: v})
+			c.ListBlocked(ctx, &pb.ListFollowsRequest{Username: u})
+			c.ListMuted(ctx, &pb.ListFollowsRequest{Username: u})
+			c.GetProfile(ctx, &pb.ProfileRequest{Username: v, Viewer: u})
+			c.GetConversation(ctx, &pb.ConversationRequest{Username: u, TweetId: id})
+			c.TweetsByHashtag(ctx, &pb.HashtagRequest{Username: u, Hashtag: "stress"})
+			c.GetTrends(ctx, &pb.TrendsRequest{})
+			c.SearchTweets(ctx, &pb.SearchRequest{Username: u, Query: "tweet"})
+			c.ListConversations(ctx, &pb.ListConversationsRequest{Username: u})
+			c.ListScheduledTweets(ctx, &pb.ListScheduledRequest{Username: u})
+			if reply, err := c.GetLists(ctx, &pb.GetListsRequest{Username: v, Viewer: u}); err == nil {
+				for _, l := range reply.Lists {
+					c.GetList(ctx, &pb.GetListRequest{ListId: l.Id, Viewer: u})
+					c.GetListTimeline(ctx, &pb.ListTimelineRequest{ListId: l.Id, Viewer: u})
+				}
+			}
+			c.HeartBeat(ctx, &pb.HeartBeatRequest{})
+			c.WhoIsPrimary(ctx, &pb.WhoisPrimaryRequest{})
+			c.StateDigest(ctx, &pb.StateDigestArgs{})
+		}
+		cancel()
+	}
+}
+
+//checkInvariants reports state of one server which no sequence of operations may leave behind
+func checkInvariants(t *testing.T, i int, srv *server) {
+	srv.store.View(func(tx *Tx) error {
+		return tx.ForEachUser(func(user User) error {
+			u := user.Username
+			//follow edges are stored in both directions, and blocked users do not follow each other
+			tx.ForEachFollow(u, func(followed string) error {
+				if tx.kv.get(followersBucket, key(followed, u)) == nil {
+					t.Errorf("server %d: %s follows %s, but is not among its followers", i, u, followed)
+				}
+				if tx.Blocked(u, followed) {
+					t.Errorf("server %d: %s follows %s although one blocked the other", i, u, followed)
+				}
+				return nil
+			})
+			tx.ForEachFollower(u, func(follower string) error {
+				if !tx.IsFollowing(follower, u) {
+					t.Errorf("server %d: %s is a follower of %s, but does not follow it", i, follower, u)
+				}
+				return nil
+			})
+			//like counts match the likes, and the likes of the user are stored in both directions
+			tx.ForEachTweet(u, func(tw tweet) error {
+				likers := 0
+				tx.kv.forEach(likesBucket, key(tweetIDKey(tw.ID), ""), func(k string, v []byte) error {
+					likers++
+					return nil
+				})
+				if tw.Likes != likers {
+					t.Errorf("server %d: tweet %d of %s has %d likes, %d users like it", i, tw.ID, u, tw.Likes, likers)
+				}
+				if tw.Poll != nil {
+					votes := 0
+					for _, option := range tw.Poll.Options {
+						votes += option.Votes
+					}
+					voters := 0
+					tx.kv.forEach(votesBucket, key(tweetIDKey(tw.ID), ""), func(k string, v []byte) error {
+						voters++
+						return nil
+					})
+					if votes != voters {
+						t.Errorf("server %d: poll %d of %s counts %d votes, %d users voted", i, tw.ID, u, votes, voters)
+					}
+				}
+				return nil
+			})
+			for _, id := range tx.likedIDs(u) {
+				if tx.kv.get(likesBucket, key(tweetIDKey(id), u)) == nil {
+					t.Errorf("server %d: %s liked tweet %d, but is not among its likes", i, u, id)
+				}
+			}
+			//list members are indexed by member
+			tx.ForEachList(u, func(l userList) error {
+				for _, member := range tx.listMembers(l.ID) {
+					if tx.kv.get(listedBucket, key(member, tweetIDKey(l.ID))) == nil {
+						t.Errorf("server %d: %s is a member of list %d, but the list is not indexed for it", i, member, l.ID)
+					}
+				}
+				return nil
+			})
+			return nil
+		})
+	})
+}
+
+func TestConcurrentRPCs(t *testing.T) {
+	//deleted accounts can be restored until they are purged
+	grace := *deleteGrace
+	*deleteGrace = time.Hour
+	defer func() { *deleteGrace = grace }()
+	servers, clients, stop := startCluster(t)
+	defer stop()
+
+	//users register concurrently, every one of them has to exist afterwards
+	var wg sync.WaitGroup
+	for w := 0; w <= stressUsers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if _, err := clients[0].Register(ctx, &pb.Credentials{Uname: stressUser(w), Pwd: "password", Broadcast: true}); err != nil {
+				t.Errorf("register %s: %s", stressUser(w), err)
+			}
+		}(w)
+	}
+	wg.Wait()
+	if t.Failed() {
+		return
+	}
+
+	ids := &tweetIDs{}
+	for w := 0; w < stressUsers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			stress(t, w, servers, clients, ids)
+		}(w)
+	}
+	wg.Wait()
+
+	//every operation was applied on all servers before its RPC returned, so the servers agree now
+	for i, srv := range servers {
+		if srv.currentOp() != servers[0].currentOp() {
+			t.Errorf("server %d is at op %d, the primary at op %d", i, srv.currentOp(), servers[0].currentOp())
+		}
+		checkInvariants(t, i, srv)
+	}
+	trees := func(srv *server) (trees []*pb.MerkleTree) {
+		srv.store.View(func(tx *Tx) error {
+			trees = buildMerkleTrees(tx)
+			return nil
+		})
+		return trees
+	}
+	primaryTrees := trees(servers[0])
+	for i, srv := range servers[1:] {
+		for k, tree := range trees(srv) {
+			if tree.Nodes[0] != primaryTrees[k].Nodes[0] {
+				t.Errorf("server %d: %s differ from the primary's", i+1, tree.Kind)
+			}
+		}
+	}
+	for _, srv := range servers {
+		srv.store.View(func(tx *Tx) error {
+			for w := 0; w < stressUsers; w++ {
+				if _, ok := tx.User(stressUser(w)); !ok {
+					t.Errorf("server %d: user %s does not exist", srv.me, stressUser(w))
+				}
+			}
+			if _, ok := tx.User(stressUser(stressUsers)); ok {
+				t.Errorf("server %d: purged user %s still exists", srv.me, stressUser(stressUsers))
+			}
+			return nil
+		})
+	}
+}
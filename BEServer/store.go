@@ -0,0 +1,631 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//Store is a storage engine for the application state: users, their tweets and the follow edges between them.
+//All access goes through transactions, so an operation either applies completely or not at all. Stores are safe
+//for concurrent use by the gRPC handlers
+type Store interface {
+	//View runs fn in a read-only transaction
+	View(fn func(tx *Tx) error) error
+	//Update runs fn in a read-write transaction. Nothing fn wrote is kept if it returns an error
+	Update(fn func(tx *Tx) error) error
+	Close() error
+}
+
+//kvTx is implemented by every storage engine: values are grouped in buckets and iterated in key order
+type kvTx interface {
+	get(bucket, key string) []byte
+	put(bucket, key string, value []byte) error
+	del(bucket, key string) error
+	forEach(bucket, prefix string, fn func(key string, value []byte) error) error
+	//forEachReverse visits the keys starting with prefix which are smaller than before, in reverse key order.
+	//An empty before visits all keys starting with prefix
+	forEachReverse(bucket, prefix, before string, fn func(key string, value []byte) error) error
+	deleteBucket(bucket string) error
+}
+
+//Tx is a transaction on a Store. It offers typed access to users, tweets and follow edges on top of the engine
+type Tx struct {
+	kv kvTx
+}
+
+const (
+	usersBucket     = "users"     // username -> User
+	tweetsBucket    = "tweets"    // username, tweet ID -> tweet
+	tweetIDsBucket  = "tweetids"  // tweet ID -> author
+	followsBucket   = "follows"   // username, followed username -> nothing
+	followersBucket = "followers" // followed username, username -> nothing
+	timelinesBucket = "timelines" // username, tweet ID -> author of the tweet
+	unfannedBucket  = "unfanned"  // author, tweet ID -> nothing, tweets which were not copied to the followers' timelines
+	likesBucket     = "likes"     // tweet ID, username -> nothing
+	likedBucket     = "liked"     // username, tweet ID -> nothing
+	retweetsBucket  = "retweets"  // original tweet ID, retweet or quote ID -> author of the retweet or quote
+	retweetedBucket = "retweeted" // username, original tweet ID -> nothing, the tweets the user retweeted
+	repliesBucket   = "replies"   // tweet ID, reply ID -> author of the reply
+
+	notificationsBucket     = "notifications"     // username, notification ID -> notification
+	notificationsReadBucket = "notificationsread" // username -> ID of the newest notification the user read
+	notificationIDsBucket   = "notificationids"   // notification ID, username -> nothing
+	hashtagsBucket          = "hashtags"          // hashtag, tweet ID -> author of the tweet
+	recentHashtagsBucket    = "recenthashtags"    // tweet ID, hashtag -> author of the tweet
+	termsBucket             = "terms"             // word, tweet ID -> positions of the word in the tweet's text
+	conversationsBucket     = "conversations"     // username, conversation ID -> the user's copy of a direct message conversation
+	messagesBucket          = "messages"          // username, conversation ID, message ID -> the user's copy of a direct message
+	messageIDsBucket        = "messageids"        // message ID, username -> nothing, conversation IDs are the IDs of their first messages
+	blocksBucket            = "blocks"            // username, blocked username -> nothing
+	blockedByBucket         = "blockedby"         // blocked username, username -> nothing
+	mutesBucket             = "mutes"             // username, muted username -> nothing
+	mutedByBucket           = "mutedby"           // muted username, username -> nothing
+	requestedBucket         = "requested"         // username, protected username -> nothing, follows waiting for approval
+	followRequestsBucket    = "followrequests"    // protected username, username -> nothing
+	votesBucket             = "votes"             // tweet ID, username -> index of the poll option the user voted for
+	votedBucket             = "voted"             // username, tweet ID -> index of the poll option the user voted for
+	scheduledBucket         = "scheduled"         // username, scheduled tweet ID -> scheduled tweet
+	scheduleBucket          = "schedule"          // publication time, username, scheduled tweet ID -> nothing, the queue of all users
+	scheduledIDsBucket      = "scheduledids"      // scheduled tweet ID -> owner
+	listsBucket             = "lists"             // owner, list ID -> list
+	listIDsBucket           = "listids"           // list ID -> owner
+	listMembersBucket       = "listmembers"       // list ID, member username -> nothing
+	listedBucket            = "listed"            // member username, list ID -> nothing, the lists the user is a member of
+)
+
+//all buckets holding application state, cleared when a server installs state from the primary
+var stateBuckets = []string{usersBucket, tweetsBucket, tweetIDsBucket, followsBucket, followersBucket, timelinesBucket, unfannedBucket,
+	likesBucket, likedBucket, retweetsBucket, retweetedBucket, repliesBucket, notificationsBucket, notificationsReadBucket,
+	notificationIDsBucket, hashtagsBucket, recentHashtagsBucket, termsBucket, conversationsBucket, messagesBucket, messageIDsBucket,
+	blocksBucket, blockedByBucket, mutesBucket, mutedByBucket, requestedBucket, followRequestsBucket, votesBucket, votedBucket,
+	scheduledBucket, scheduleBucket, scheduledIDsBucket, listsBucket, listIDsBucket, listMembersBucket, listedBucket}
+
+var errUserExists = errors.New("user already exists")
+var errNoSuchUser = errors.New("no such user")
+var errNoSuchTweet = errors.New("no such tweet")
+var errNotAuthor = errors.New("only the author can modify a tweet")
+var errNotFollowing = errors.New("user is not followed")
+var errAccountDeleted = errors.New("account is deleted")
+var errRetweetEdit = errors.New("a retweet has no text to edit")
+
+//errStopIteration is returned by an iteration callback to stop early, it is not returned to the caller
+var errStopIteration = errors.New("stop iteration")
+
+type User struct {
+	Username  string
+	Password  string
+	DeletedAt int64 // time the account was deleted in unix milliseconds, 0 if it is active. It can be restored until it is purged
+	OpenDMs   bool  // whether users the user does not follow can start conversations with it
+	Protected bool  // whether only approved followers see the user's tweets
+
+	//profile
+	DisplayName string
+	Bio         string
+	Location    string
+	Website     string
+	Avatar      string // blob ID of the avatar image, empty if there is none
+}
+
+type tweet struct {
+	ID        int64
+	Author    string
+	Text      string
+	Timestamp int64       // creation time in unix milliseconds, fixed by the primary when the tweet is logged
+	EditedAt  int64       // time of the latest edit, 0 if the tweet was never edited
+	History   []tweetEdit // previous versions of the text, oldest first
+	Likes     int         // number of users who liked the tweet
+	RetweetOf int64       // ID of the retweeted tweet, a retweet has no text of its own
+	QuoteOf   int64       // ID of the quoted tweet
+	Retweets  int         // number of retweets of the tweet
+	Quotes    int         // number of quotes of the tweet
+	ReplyTo   int64       // ID of the tweet this tweet replies to
+	Replies   int         // number of direct replies to the tweet
+	Mentions  []string    // the users mentioned in the text
+	Hashtags  []string    // the hashtags in the text, lower case
+	Media     []media     // the attached images
+	Poll      *poll       // the attached poll, nil for a tweet without a poll
+}
+
+type tweetEdit struct {
+	Text      string
+	Timestamp int64
+}
+
+//newTweetID builds a tweet ID from the tweet's creation time and the op it was logged at. IDs sort by creation
+//time. The ID alone is not unique: the op number is cut to 16 bits and an op number is logged again after a view
+//change, freeID picks an ID which is not taken
+func newTweetID(timestamp int64, op int) int64 {
+	return timestamp<<16 | int64(op)&0xffff
+}
+
+//freeID returns the first ID from newTweetID(timestamp, op) on which no key of the ID index buckets starts with.
+//The primary calls it holding opMu, when every logged operation is applied, before it logs the operation with the
+//ID
+func (tx *Tx) freeID(timestamp int64, op int, buckets ...string) int64 {
+	id := newTweetID(timestamp, op)
+	for tx.idTaken(id, buckets) {
+		id++
+	}
+	return id
+}
+
+//idTaken reports whether a key of one of the buckets starts with the ID. IDs are formatted with a fixed width, so
+//no other ID is a prefix of it
+func (tx *Tx) idTaken(id int64, buckets []string) bool {
+	for _, bucket := range buckets {
+		taken := false
+		tx.kv.forEach(bucket, tweetIDKey(id), func(k string, v []byte) error {
+			taken = true
+			return errStopIteration
+		})
+		if taken {
+			return true
+		}
+	}
+	return false
+}
+
+//tweetKey keeps a user's tweets in ID order
+func tweetKey(username string, id int64) string {
+	return key(username, tweetIDKey(id))
+}
+
+func tweetIDKey(id int64) string {
+	return fmt.Sprintf("%020d", id)
+}
+
+//openStore opens the storage engine selected on the command line
+func openStore(engine string, path string) (Store, error) {
+	switch engine {
+	case "memory":
+		return newMemoryStore(), nil
+	case "bolt":
+		return newBoltStore(path)
+	}
+	return nil, fmt.Errorf("unknown storage engine %q", engine)
+}
+
+//key joins the parts of a composite key. Keys sharing their leading parts are stored next to each other
+func key(parts ...string) string {
+	return strings.Join(parts, "\x00")
+}
+
+func (tx *Tx) getJSON(bucket, k string, v interface{}) bool {
+	data := tx.kv.get(bucket, k)
+	if data == nil {
+		return false
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		fmt.Printf("Error: Corrupt record %q in bucket %s: %s \n", k, bucket, err)
+		return false
+	}
+	return true
+}
+
+func (tx *Tx) putJSON(bucket, k string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return tx.kv.put(bucket, k, data)
+}
+
+//deletePrefix removes every key of the bucket starting with prefix
+func (tx *Tx) deletePrefix(bucket, prefix string) error {
+	var keys []string
+	err := tx.kv.forEach(bucket, prefix, func(k string, v []byte) error {
+		keys = append(keys, k)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := tx.kv.del(bucket, k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Reset removes all application state
+func (tx *Tx) Reset() error {
+	for _, bucket := range stateBuckets {
+		if err := tx.kv.deleteBucket(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *Tx) User(username string) (User, bool) {
+	var user User
+	ok := tx.getJSON(usersBucket, username, &user)
+	return user, ok
+}
+
+//ActiveUser looks up a user whose account was not deleted
+func (tx *Tx) ActiveUser(username string) (User, bool) {
+	user, ok := tx.User(username)
+	return user, ok && user.DeletedAt == 0
+}
+
+func (tx *Tx) PutUser(user User) error {
+	return tx.putJSON(usersBucket, user.Username, user)
+}
+
+//DeactivateUser marks the account as deleted. Its data is kept until it is restored or purged
+func (tx *Tx) DeactivateUser(username string, deletedAt int64) error {
+	user, ok := tx.User(username)
+	if !ok {
+		return errNoSuchUser
+	}
+	user.DeletedAt = deletedAt
+	return tx.PutUser(user)
+}
+
+//PurgeUser deletes the user and removes it from the follow graph and the timelines of other users
+func (tx *Tx) PurgeUser(username string) error {
+	if _, ok := tx.User(username); !ok {
+		return errNoSuchUser
+	}
+	var followers []string
+	tx.ForEachFollower(username, func(follower string) error {
+		followers = append(followers, follower)
+		return nil
+	})
+	var ids []int64
+	tx.ForEachTweet(username, func(t tweet) error {
+		ids = append(ids, t.ID)
+		return nil
+	})
+	for _, id := range ids {
+		//a retweet of one of the user's own tweets is gone with the original
+		if err := tx.DeleteTweet(username, id); err != nil && err != errNoSuchTweet {
+			return err
+		}
+	}
+	for _, id := range tx.likedIDs(username) {
+		if _, err := tx.Unlike(username, id); err != nil {
+			return err
+		}
+	}
+	for _, v := range tx.votes(username) {
+		if err := tx.unvote(username, v.TweetID); err != nil {
+			return err
+		}
+	}
+	if err := tx.deleteRelations(username, true); err != nil {
+		return err
+	}
+	if err := tx.deleteFollowRequests(username, true); err != nil {
+		return err
+	}
+	if err := tx.deleteLists(username, true); err != nil {
+		return err
+	}
+	for _, follower := range followers {
+		if err := tx.kv.del(followsBucket, key(follower, username)); err != nil {
+			return err
+		}
+		if err := tx.kv.del(followersBucket, key(username, follower)); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if err := tx.kv.del(timelinesBucket, tweetKey(follower, id)); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.DeleteUser(username)
+}
+
+//DeleteUser removes the user together with its tweets, its timeline and the users it follows. Other users'
+//references to it are kept, PurgeUser removes them as well
+func (tx *Tx) DeleteUser(username string) error {
+	if err := tx.kv.del(usersBucket, username); err != nil {
+		return err
+	}
+	var tweets []tweet
+	tx.ForEachTweet(username, func(t tweet) error {
+		tweets = append(tweets, t)
+		return nil
+	})
+	for _, t := range tweets {
+		if err := tx.kv.del(tweetIDsBucket, tweetIDKey(t.ID)); err != nil {
+			return err
+		}
+		if err := tx.unindexRetweet(t); err != nil {
+			return err
+		}
+		if err := tx.unindexReply(t); err != nil {
+			return err
+		}
+		if err := tx.unindexHashtags(t); err != nil {
+			return err
+		}
+		if err := tx.unindexText(t); err != nil {
+			return err
+		}
+	}
+	var followed []string
+	tx.ForEachFollow(username, func(f string) error {
+		followed = append(followed, f)
+		return nil
+	})
+	for _, f := range followed {
+		if err := tx.kv.del(followersBucket, key(f, username)); err != nil {
+			return err
+		}
+	}
+	for _, id := range tx.likedIDs(username) {
+		if err := tx.kv.del(likesBucket, key(tweetIDKey(id), username)); err != nil {
+			return err
+		}
+	}
+	for _, v := range tx.votes(username) {
+		if err := tx.kv.del(votesBucket, voteKey(v.TweetID, username)); err != nil {
+			return err
+		}
+	}
+	if err := tx.deleteRelations(username, false); err != nil {
+		return err
+	}
+	if err := tx.deleteFollowRequests(username, false); err != nil {
+		return err
+	}
+	if err := tx.deleteScheduledTweets(username); err != nil {
+		return err
+	}
+	if err := tx.deleteLists(username, false); err != nil {
+		return err
+	}
+	if err := tx.deleteMailbox(username); err != nil {
+		return err
+	}
+	for _, bucket := range []string{tweetsBucket, followsBucket, timelinesBucket, unfannedBucket, likedBucket, votedBucket} {
+		if err := tx.deletePrefix(bucket, key(username, "")); err != nil {
+			return err
+		}
+	}
+	return tx.deleteNotifications(username)
+}
+
+//ForEachUser calls fn for every user in username order
+func (tx *Tx) ForEachUser(fn func(user User) error) error {
+	return tx.kv.forEach(usersBucket, "", func(k string, v []byte) error {
+		var user User
+		if err := json.Unmarshal(v, &user); err != nil {
+			return err
+		}
+		return fn(user)
+	})
+}
+
+//AddTweet adds a tweet to the user's tweets and to the timelines of its followers, and notifies the users it
+//mentions
+func (tx *Tx) AddTweet(username string, t tweet) error {
+	t.Author = username
+	t.Mentions = tx.mentions(username, t.Text)
+	t.Hashtags = hashtags(t.Text)
+	if err := tx.PutTweet(username, t); err != nil {
+		return err
+	}
+	if err := tx.fanOut(t); err != nil {
+		return err
+	}
+	return tx.notifyMentions(t)
+}
+
+//PutTweet adds a tweet to the user's tweets only, timelines have to be rebuilt afterwards
+func (tx *Tx) PutTweet(username string, t tweet) error {
+	if _, ok := tx.User(username); !ok {
+		return errNoSuchUser
+	}
+	t.Author = username
+	if err := tx.kv.put(tweetIDsBucket, tweetIDKey(t.ID), []byte(username)); err != nil {
+		return err
+	}
+	if err := tx.indexRetweet(t); err != nil {
+		return err
+	}
+	if err := tx.indexReply(t); err != nil {
+		return err
+	}
+	if err := tx.indexHashtags(t); err != nil {
+		return err
+	}
+	if err := tx.indexText(t); err != nil {
+		return err
+	}
+	return tx.putJSON(tweetsBucket, tweetKey(username, t.ID), t)
+}
+
+//Tweet looks up a tweet by its author and ID
+func (tx *Tx) Tweet(author string, id int64) (tweet, bool) {
+	var t tweet
+	ok := tx.getJSON(tweetsBucket, tweetKey(author, id), &t)
+	return t, ok
+}
+
+//TweetByID looks up a tweet by its ID only
+func (tx *Tx) TweetByID(id int64) (tweet, bool) {
+	author := tx.kv.get(tweetIDsBucket, tweetIDKey(id))
+	if author == nil {
+		return tweet{}, false
+	}
+	return tx.Tweet(string(author), id)
+}
+
+//OwnTweet returns the tweet if it exists and username is its author
+func (tx *Tx) OwnTweet(username string, id int64) (tweet, error) {
+	t, ok := tx.TweetByID(id)
+	if !ok {
+		return t, errNoSuchTweet
+	}
+	if t.Author != username {
+		return t, errNotAuthor
+	}
+	return t, nil
+}
+
+//DeleteTweet removes one of the user's tweets. Copies in timelines are skipped when the timelines are read
+func (tx *Tx) DeleteTweet(username string, id int64) error {
+	t, err := tx.OwnTweet(username, id)
+	if err != nil {
+		return err
+	}
+	if err := tx.deleteRetweets(t); err != nil {
+		return err
+	}
+	if err := tx.deleteReply(t); err != nil {
+		return err
+	}
+	if err := tx.unindexHashtags(t); err != nil {
+		return err
+	}
+	if err := tx.unindexText(t); err != nil {
+		return err
+	}
+	for _, bucket := range []string{tweetsBucket, unfannedBucket} {
+		if err := tx.kv.del(bucket, tweetKey(username, id)); err != nil {
+			return err
+		}
+	}
+	if err := tx.deleteLikes(id); err != nil {
+		return err
+	}
+	if err := tx.deleteVotes(id); err != nil {
+		return err
+	}
+	return tx.kv.del(tweetIDsBucket, tweetIDKey(id))
+}
+
+//EditTweet replaces the text of one of the user's tweets and keeps the previous text in the tweet's history
+func (tx *Tx) EditTweet(username string, id int64, text string, timestamp int64) error {
+	t, err := tx.OwnTweet(username, id)
+	if err != nil {
+		return err
+	}
+	if t.RetweetOf != 0 {
+		return errRetweetEdit
+	}
+	written := t.Timestamp
+	if t.EditedAt != 0 {
+		written = t.EditedAt
+	}
+	t.History = append(t.History, tweetEdit{Text: t.Text, Timestamp: written})
+	if err := tx.unindexHashtags(t); err != nil {
+		return err
+	}
+	if err := tx.unindexText(t); err != nil {
+		return err
+	}
+	t.Text = text
+	t.Mentions = tx.mentions(username, text)
+	t.Hashtags = hashtags(text)
+	t.EditedAt = timestamp
+	if err := tx.indexHashtags(t); err != nil {
+		return err
+	}
+	if err := tx.indexText(t); err != nil {
+		return err
+	}
+	return tx.putJSON(tweetsBucket, tweetKey(username, id), t)
+}
+
+//ForEachTweet calls fn for every tweet of the user, oldest first
+func (tx *Tx) ForEachTweet(username string, fn func(t tweet) error) error {
+	return tx.kv.forEach(tweetsBucket, key(username, ""), func(k string, v []byte) error {
+		var t tweet
+		if err := json.Unmarshal(v, &t); err != nil {
+			return err
+		}
+		return fn(t)
+	})
+}
+
+//ForEachTweetBefore calls fn for the user's tweets older than before, newest first. A before below zero starts at
+//the newest tweet
+func (tx *Tx) ForEachTweetBefore(username string, before int64, fn func(t tweet) error) error {
+	err := tx.kv.forEachReverse(tweetsBucket, key(username, ""), timelineKey(username, before), func(k string, v []byte) error {
+		var t tweet
+		if err := json.Unmarshal(v, &t); err != nil {
+			return err
+		}
+		return fn(t)
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//Follow adds the follow edge and copies the followed user's tweets into the user's timeline
+func (tx *Tx) Follow(username, followed string) error {
+	if tx.IsFollowing(username, followed) {
+		return nil
+	}
+	if err := tx.putFollow(username, followed); err != nil {
+		return err
+	}
+	return tx.backfill(username, followed)
+}
+
+//Unfollow removes the follow edge and the followed user's tweets from the user's timeline
+func (tx *Tx) Unfollow(username, followed string) error {
+	if !tx.IsFollowing(username, followed) {
+		return errNotFollowing
+	}
+	if err := tx.kv.del(followsBucket, key(username, followed)); err != nil {
+		return err
+	}
+	if err := tx.kv.del(followersBucket, key(followed, username)); err != nil {
+		return err
+	}
+	if username == followed {
+		//the user's own tweets stay in its timeline
+		return nil
+	}
+	var ids []int64
+	tx.ForEachTweet(followed, func(t tweet) error {
+		ids = append(ids, t.ID)
+		return nil
+	})
+	for _, id := range ids {
+		if err := tx.kv.del(timelinesBucket, tweetKey(username, id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//putFollow adds the follow edge only, timelines have to be rebuilt afterwards
+func (tx *Tx) putFollow(username, followed string) error {
+	if err := tx.kv.put(followsBucket, key(username, followed), []byte{}); err != nil {
+		return err
+	}
+	return tx.kv.put(followersBucket, key(followed, username), []byte{})
+}
+
+func (tx *Tx) IsFollowing(username, followed string) bool {
+	return tx.kv.get(followsBucket, key(username, followed)) != nil
+}
+
+//ForEachFollow calls fn for every user followed by username, in username order
+func (tx *Tx) ForEachFollow(username string, fn func(followed string) error) error {
+	prefix := key(username, "")
+	return tx.kv.forEach(followsBucket, prefix, func(k string, v []byte) error {
+		return fn(strings.TrimPrefix(k, prefix))
+	})
+}
+
+//ForEachFollower calls fn for every user following username, in username order
+func (tx *Tx) ForEachFollower(username string, fn func(follower string) error) error {
+	prefix := key(username, "")
+	return tx.kv.forEach(followersBucket, prefix, func(k string, v []byte) error {
+		return fn(strings.TrimPrefix(k, prefix))
+	})
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+//boltStore keeps the application state in an embedded on-disk database, so it does not have to fit in memory
+type boltStore struct {
+	db *bolt.DB
+}
+
+func newBoltStore(path string) (*boltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	return &boltStore{db: db}, nil
+}
+
+func (s *boltStore) View(fn func(tx *Tx) error) error {
+	return s.db.View(func(btx *bolt.Tx) error {
+		return fn(&Tx{kv: boltTx{btx}})
+	})
+}
+
+func (s *boltStore) Update(fn func(tx *Tx) error) error {
+	return s.db.Update(func(btx *bolt.Tx) error {
+		return fn(&Tx{kv: boltTx{btx}})
+	})
+}
+
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t boltTx) get(bucket, key string) []byte {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	value := b.Get([]byte(key))
+	if value == nil {
+		return nil
+	}
+	//values returned by bolt are only valid for the lifetime of the transaction
+	return append([]byte{}, value...)
+}
+
+func (t boltTx) put(bucket, key string, value []byte) error {
+	b, err := t.tx.CreateBucketIfNotExists([]byte(bucket))
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(key), value)
+}
+
+func (t boltTx) del(bucket, key string) error {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	return b.Delete([]byte(key))
+}
+
+func (t boltTx) forEach(bucket, prefix string, fn func(key string, value []byte) error) error {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	p := []byte(prefix)
+	c := b.Cursor()
+	for k, v := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, v = c.Next() {
+		if err := fn(string(k), append([]byte{}, v...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t boltTx) forEachReverse(bucket, prefix, before string, fn func(key string, value []byte) error) error {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	p := []byte(prefix)
+	//keys are built from text and separators, so no key starting with prefix sorts after this one
+	upper := append([]byte(prefix), 0xff)
+	if before != "" && before < string(upper) {
+		upper = []byte(before)
+	}
+	c := b.Cursor()
+	//Seek finds the first key >= upper, the key before it is the largest key < upper
+	k, v := c.Seek(upper)
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+	for ; k != nil && bytes.HasPrefix(k, p); k, v = c.Prev() {
+		if err := fn(string(k), append([]byte{}, v...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t boltTx) deleteBucket(bucket string) error {
+	err := t.tx.DeleteBucket([]byte(bucket))
+	if err == bolt.ErrBucketNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"hash/fnv"
+	"strings"
+	"sync"
+)
+
+//memoryStore keeps all application state in memory, every bucket is an ordered tree of its keys. It is the default
+//storage engine. The trees are never changed in place: a read-write transaction copies the nodes it changes and
+//replaces the committed trees when it succeeds. A read-only transaction works on the trees committed when it
+//started, so it runs at the same time as the read-write transaction, which runs alone
+type memoryStore struct {
+	writeMu sync.Mutex   // serializes read-write transactions
+	mu      sync.RWMutex // protects buckets
+	buckets map[string]*memoryNode
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{buckets: make(map[string]*memoryNode)}
+}
+
+func (s *memoryStore) View(fn func(tx *Tx) error) error {
+	s.mu.RLock()
+	buckets := s.buckets
+	s.mu.RUnlock()
+	return fn(&Tx{kv: &memoryTx{buckets: buckets}})
+}
+
+func (s *memoryStore) Update(fn func(tx *Tx) error) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	mtx := &memoryTx{buckets: make(map[string]*memoryNode), writable: true}
+	s.mu.RLock()
+	for bucket, root := range s.buckets {
+		mtx.buckets[bucket] = root
+	}
+	s.mu.RUnlock()
+	//the committed trees are left alone if fn fails
+	if err := fn(&Tx{kv: mtx}); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.buckets = mtx.buckets
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+type memoryTx struct {
+	buckets  map[string]*memoryNode // the root of every bucket's tree
+	writable bool
+}
+
+var errReadOnlyTx = errors.New("write in a read-only transaction")
+
+func (tx *memoryTx) get(bucket, key string) []byte {
+	n := tx.buckets[bucket].find(key)
+	if n == nil {
+		return nil
+	}
+	return n.value
+}
+
+func (tx *memoryTx) put(bucket, key string, value []byte) error {
+	if !tx.writable {
+		return errReadOnlyTx
+	}
+	tx.buckets[bucket] = tx.buckets[bucket].insert(key, value, keyPriority(key))
+	return nil
+}
+
+func (tx *memoryTx) del(bucket, key string) error {
+	if !tx.writable {
+		return errReadOnlyTx
+	}
+	if root, ok := tx.buckets[bucket]; ok {
+		tx.buckets[bucket] = root.remove(key)
+	}
+	return nil
+}
+
+func (tx *memoryTx) forEach(bucket, prefix string, fn func(key string, value []byte) error) error {
+	var err error
+	tx.buckets[bucket].ascend(prefix, func(n *memoryNode) bool {
+		if !strings.HasPrefix(n.key, prefix) {
+			return false
+		}
+		err = tx.visit(bucket, n, fn)
+		return err == nil
+	})
+	return err
+}
+
+func (tx *memoryTx) forEachReverse(bucket, prefix, before string, fn func(key string, value []byte) error) error {
+	upper := prefix + "\xff"
+	if before != "" && before < upper {
+		upper = before
+	}
+	var err error
+	tx.buckets[bucket].descend(upper, func(n *memoryNode) bool {
+		if !strings.HasPrefix(n.key, prefix) {
+			return false
+		}
+		err = tx.visit(bucket, n, fn)
+		return err == nil
+	})
+	return err
+}
+
+//visit calls fn for a node of the tree an iteration started on. fn may have changed keys we have not reached yet
+func (tx *memoryTx) visit(bucket string, n *memoryNode, fn func(key string, value []byte) error) error {
+	value := n.value
+	if tx.writable {
+		current := tx.buckets[bucket].find(n.key)
+		if current == nil {
+			return nil
+		}
+		value = current.value
+	}
+	return fn(n.key, value)
+}
+
+func (tx *memoryTx) deleteBucket(bucket string) error {
+	if !tx.writable {
+		return errReadOnlyTx
+	}
+	delete(tx.buckets, bucket)
+	return nil
+}
+
+//memoryNode is a node of a treap: a binary search tree over the keys which is a heap over the priorities of the
+//keys, so it stays balanced. Nodes are immutable once a transaction is committed
+type memoryNode struct {
+	key         string
+	value       []byte
+	priority    uint32
+	left, right *memoryNode
+}
+
+//keyPriority derives the priority of a key from its hash, so the shape of a tree only depends on its keys
+func keyPriority(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()
+}
+
+func (n *memoryNode) find(key string) *memoryNode {
+	for n != nil && n.key != key {
+		if key < n.key {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return n
+}
+
+//insert returns the root of a tree which is n with key set to value, copying the nodes on the path to the key
+func (n *memoryNode) insert(key string, value []byte, priority uint32) *memoryNode {
+	if n == nil {
+		return &memoryNode{key: key, value: value, priority: priority}
+	}
+	c := *n
+	switch {
+	case key == n.key:
+		c.value = value
+	case key < n.key:
+		c.left = n.left.insert(key, value, priority)
+		if c.left.priority > c.priority {
+			//rotate right, the new left child is a copy already
+			l := c.left
+			c.left = l.right
+			l.right = &c
+			return l
+		}
+	default:
+		c.right = n.right.insert(key, value, priority)
+		if c.right.priority > c.priority {
+			r := c.right
+			c.right = r.left
+			r.left = &c
+			return r
+		}
+	}
+	return &c
+}
+
+//remove returns the root of a tree which is n without key, copying the nodes on the path to the key
+func (n *memoryNode) remove(key string) *memoryNode {
+	if n == nil {
+		return nil
+	}
+	if key == n.key {
+		return mergeNodes(n.left, n.right)
+	}
+	c := *n
+	if key < n.key {
+		c.left = n.left.remove(key)
+	} else {
+		c.right = n.right.remove(key)
+	}
+	return &c
+}
+
+//mergeNodes joins two trees whose keys are all smaller in a than in b
+func mergeNodes(a, b *memoryNode) *memoryNode {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		c := *a
+		c.right = mergeNodes(a.right, b)
+		return &c
+	}
+	c := *b
+	c.left = mergeNodes(a, b.left)
+	return &c
+}
+
+//ascend calls fn for the nodes with keys >= from in key order until fn returns false
+func (n *memoryNode) ascend(from string, fn func(n *memoryNode) bool) bool {
+	if n == nil {
+		return true
+	}
+	if from <= n.key {
+		if !n.left.ascend(from, fn) || !fn(n) {
+			return false
+		}
+	}
+	return n.right.ascend(from, fn)
+}
+
+//descend calls fn for the nodes with keys < upper in reverse key order until fn returns false
+func (n *memoryNode) descend(upper string, fn func(n *memoryNode) bool) bool {
+	if n == nil {
+		return true
+	}
+	if n.key < upper {
+		if !n.right.descend(upper, fn) || !fn(n) {
+			return false
+		}
+	}
+	return n.left.descend(upper, fn)
+}
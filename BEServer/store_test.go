@@ -0,0 +1,239 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+//The store tests run the same operations on both storage engines and check that they visit the same keys as a
+//sorted list of the keys does
+
+//testKeys are keys of one bucket the way the Tx methods build them: composite keys sharing their leading parts
+var testKeys = func() []string {
+	var keys []string
+	for _, username := range []string{"a", "ab", "b", "user1", "user10", "user2"} {
+		keys = append(keys, username)
+		for id := int64(1); id <= 5; id++ {
+			keys = append(keys, tweetKey(username, id*1000))
+		}
+		keys = append(keys, key(username, "z"))
+	}
+	return keys
+}()
+
+var testPrefixes = []string{"", "a", key("a", ""), key("ab", ""), "user1", key("user1", ""), key("user2", ""), "x", key("zz", "")}
+
+//openTestStores returns a store of each engine holding testKeys in the bucket "test", each key's value is the key
+func openTestStores(t *testing.T) (stores map[string]Store, cleanup func()) {
+	dir, err := ioutil.TempDir("", "store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stores = make(map[string]Store)
+	engines := []string{"memory", "bolt"}
+	if raceDetector {
+		engines = engines[:1]
+	}
+	for _, engine := range engines {
+		s, err := openStore(engine, filepath.Join(dir, "state.db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.Update(func(tx *Tx) error {
+			//put in an order which is not the key order
+			for i := len(testKeys) - 1; i >= 0; i-- {
+				if err := tx.kv.put("test", testKeys[i], []byte(testKeys[i])); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stores[engine] = s
+	}
+	return stores, func() {
+		for _, s := range stores {
+			s.Close()
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+//expectedKeys returns the testKeys starting with prefix and smaller than before, if before is not empty, in key order
+func expectedKeys(prefix, before string) []string {
+	var keys []string
+	for _, k := range testKeys {
+		if len(k) >= len(prefix) && k[:len(prefix)] == prefix && (before == "" || k < before) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestStoreForEach(t *testing.T) {
+	stores, cleanup := openTestStores(t)
+	defer cleanup()
+	for engine, s := range stores {
+		for _, prefix := range testPrefixes {
+			var visited []string
+			s.View(func(tx *Tx) error {
+				return tx.kv.forEach("test", prefix, func(k string, v []byte) error {
+					if string(v) != k {
+						t.Errorf("%s: key %q has value %q", engine, k, v)
+					}
+					visited = append(visited, k)
+					return nil
+				})
+			})
+			if want := expectedKeys(prefix, ""); !reflect.DeepEqual(visited, want) {
+				t.Errorf("%s: forEach with prefix %q visited %q, want %q", engine, prefix, visited, want)
+			}
+		}
+	}
+}
+
+func TestStoreForEachReverse(t *testing.T) {
+	stores, cleanup := openTestStores(t)
+	defer cleanup()
+	befores := []string{"", tweetKey("a", 3000), tweetKey("user1", 1), key("user1", "z"), key("user10", ""), "user2", "zz"}
+	for engine, s := range stores {
+		for _, prefix := range testPrefixes {
+			for _, before := range befores {
+				var visited []string
+				s.View(func(tx *Tx) error {
+					return tx.kv.forEachReverse("test", prefix, before, func(k string, v []byte) error {
+						visited = append([]string{k}, visited...)
+						return nil
+					})
+				})
+				if want := expectedKeys(prefix, before); !reflect.DeepEqual(visited, want) {
+					t.Errorf("%s: forEachReverse with prefix %q before %q visited %q in reverse, want %q", engine, prefix,
+						before, visited, want)
+				}
+			}
+		}
+	}
+}
+
+func TestStoreStopIteration(t *testing.T) {
+	stores, cleanup := openTestStores(t)
+	defer cleanup()
+	for engine, s := range stores {
+		var first, last string
+		err := s.View(func(tx *Tx) error {
+			if err := tx.kv.forEach("test", "user", func(k string, v []byte) error {
+				first = k
+				return errStopIteration
+			}); err != errStopIteration {
+				return err
+			}
+			if err := tx.kv.forEachReverse("test", "user", "", func(k string, v []byte) error {
+				last = k
+				return errStopIteration
+			}); err != errStopIteration {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("%s: %s", engine, err)
+		}
+		if first != "user1" || last != key("user2", "z") {
+			t.Errorf("%s: iterations stopped at %q and %q", engine, first, last)
+		}
+	}
+}
+
+func TestStoreUpdate(t *testing.T) {
+	stores, cleanup := openTestStores(t)
+	defer cleanup()
+	for engine, s := range stores {
+		//a failed transaction leaves nothing behind
+		failed := errors.New("failed")
+		err := s.Update(func(tx *Tx) error {
+			tx.kv.put("test", "new", []byte("new"))
+			tx.kv.del("test", "a")
+			tx.kv.deleteBucket("other")
+			return failed
+		})
+		if err != failed {
+			t.Errorf("%s: failed update returned %v", engine, err)
+		}
+		s.View(func(tx *Tx) error {
+			if tx.kv.get("test", "new") != nil || tx.kv.get("test", "a") == nil {
+				t.Errorf("%s: the failed update was applied", engine)
+			}
+			return nil
+		})
+	}
+}
+
+//TestMemoryStoreSnapshot checks that a read-only transaction of the memory store sees the state it started at
+func TestMemoryStoreSnapshot(t *testing.T) {
+	s := newMemoryStore()
+	put := func(keys ...string) {
+		s.Update(func(tx *Tx) error {
+			for _, k := range keys {
+				tx.kv.put("test", k, []byte(k))
+			}
+			return nil
+		})
+	}
+	put("b", "d")
+	s.View(func(tx *Tx) error {
+		put("a", "c", "e")
+		s.Update(func(tx *Tx) error {
+			return tx.kv.del("test", "b")
+		})
+		var visited []string
+		tx.kv.forEach("test", "", func(k string, v []byte) error {
+			visited = append(visited, k)
+			return nil
+		})
+		if fmt.Sprint(visited) != "[b d]" {
+			t.Errorf("view visited %q", visited)
+		}
+		return nil
+	})
+}
+
+func TestFreeID(t *testing.T) {
+	s := newMemoryStore()
+	id := newTweetID(1000, 1)
+	s.Update(func(tx *Tx) error {
+		tx.putNotification("a", notification{ID: id})
+		tx.putNotification("b", notification{ID: id + 1})
+		return tx.kv.put(tweetIDsBucket, tweetIDKey(id+2), []byte("a"))
+	})
+	s.View(func(tx *Tx) error {
+		if free := tx.freeID(1000, 1, notificationIDsBucket); free != id+2 {
+			t.Errorf("free notification ID is %d, want %d", free, id+2)
+		}
+		if free := tx.freeID(1000, 1, tweetIDsBucket, notificationIDsBucket); free != id+3 {
+			t.Errorf("free tweet ID is %d, want %d", free, id+3)
+		}
+		if free := tx.freeID(1000, 1, tweetIDsBucket); free != id {
+			t.Errorf("free tweet ID without notifications is %d, want %d", free, id)
+		}
+		return nil
+	})
+	//an ID is free again when nothing uses it any more
+	s.Update(func(tx *Tx) error {
+		return tx.deleteNotifications("a")
+	})
+	s.View(func(tx *Tx) error {
+		if free := tx.freeID(1000, 1, notificationIDsBucket); free != id {
+			t.Errorf("free notification ID after deleting the inbox is %d, want %d", free, id)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/context"
+	pb "twitter-distributed/utils/ProtoDef"
+)
+
+//Timelines are materialized on write: a new tweet is copied into the timeline of every follower of its author.
+//Tweets of authors with more than fanOutLimit followers are not copied, since one tweet would cost that many
+//writes. They are recorded as unfanned instead and every follower pulls them when reading its timeline.
+
+const (
+	defaultTimelineLimit = 20
+	maxTimelineLimit     = 100
+	fanOutLimit          = 1000 // authors with more followers than this are pulled by their followers
+)
+
+var errBadCursor = errors.New("invalid timeline cursor")
+
+//A cursor is the ID of the last tweet of a page, the next page starts with the tweet before it.
+//Clients should treat it as opaque
+func encodeCursor(id int64) string {
+	return strconv.FormatInt(id, 36)
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	id, err := strconv.ParseInt(cursor, 36, 64)
+	if err != nil {
+		return 0, errBadCursor
+	}
+	return id, nil
+}
+
+//timelineKey is the key of a tweet in a timeline, an ID below zero gives the key after all tweets of the timeline
+func timelineKey(username string, id int64) string {
+	if id < 0 {
+		return ""
+	}
+	return tweetKey(username, id)
+}
+
+//fanOut adds a new tweet to its author's timeline and either to its followers' timelines or to the unfanned tweets
+func (tx *Tx) fanOut(t tweet) error {
+	if err := tx.kv.put(timelinesBucket, tweetKey(t.Author, t.ID), []byte(t.Author)); err != nil {
+		return err
+	}
+	var followers []string
+	tx.ForEachFollower(t.Author, func(follower string) error {
+		followers = append(followers, follower)
+		return nil
+	})
+	if len(followers) > fanOutLimit {
+		return tx.kv.put(unfannedBucket, tweetKey(t.Author, t.ID), []byte{})
+	}
+	for _, follower := range followers {
+		if err := tx.kv.put(timelinesBucket, tweetKey(follower, t.ID), []byte(t.Author)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//backfill copies the tweets of a newly followed user into the user's timeline. Unfanned tweets are pulled anyway
+func (tx *Tx) backfill(username, followed string) error {
+	var ids []int64
+	tx.ForEachTweet(followed, func(t tweet) error {
+		if tx.kv.get(unfannedBucket, tweetKey(followed, t.ID)) == nil {
+			ids = append(ids, t.ID)
+		}
+		return nil
+	})
+	for _, id := range ids {
+		if err := tx.kv.put(timelinesBucket, tweetKey(username, id), []byte(followed)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//RebuildTimelines recomputes all timelines from the tweets and follow edges, after they were stored without fan-out
+func (tx *Tx) RebuildTimelines() error {
+	if err := tx.kv.deleteBucket(timelinesBucket); err != nil {
+		return err
+	}
+	if err := tx.kv.deleteBucket(unfannedBucket); err != nil {
+		return err
+	}
+	var tweets []tweet
+	tx.ForEachUser(func(user User) error {
+		return tx.ForEachTweet(user.Username, func(t tweet) error {
+			tweets = append(tweets, t)
+			return nil
+		})
+	})
+	for _, t := range tweets {
+		if err := tx.fanOut(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//ForEachTimelineTweet calls fn for the tweets in the user's materialized timeline older than before, newest first.
+//A before below zero starts at the newest tweet. Tweets which were deleted since they were copied are skipped
+func (tx *Tx) ForEachTimelineTweet(username string, before int64, fn func(t tweet) error) error {
+	prefix := key(username, "")
+	err := tx.kv.forEachReverse(timelinesBucket, prefix, timelineKey(username, before), func(k string, v []byte) error {
+		id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64)
+		if err != nil {
+			return err
+		}
+		if t, ok := tx.Tweet(string(v), id); ok {
+			return fn(t)
+		}
+		return nil
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//ForEachUnfannedTweet calls fn for the author's unfanned tweets older than before, newest first
+func (tx *Tx) ForEachUnfannedTweet(author string, before int64, fn func(t tweet) error) error {
+	prefix := key(author, "")
+	err := tx.kv.forEachReverse(unfannedBucket, prefix, timelineKey(author, before), func(k string, v []byte) error {
+		id, err := strconv.ParseInt(strings.TrimPrefix(k, prefix), 10, 64)
+		if err != nil {
+			return err
+		}
+		if t, ok := tx.Tweet(author, id); ok {
+			return fn(t)
+		}
+		return nil
+	})
+	if err == errStopIteration {
+		return nil
+	}
+	return err
+}
+
+//HomeTimeline merges the user's own tweets and the tweets of the users it follows, newest first
+func (s *server) HomeTimeline(ctx context.Context, in *pb.HomeTimelineRequest) (*pb.HomeTimelineResponse, error) {
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	} else if limit > maxTimelineLimit {
+		limit = maxTimelineLimit
+	}
+	before := int64(-1)
+	if in.Cursor != "" {
+		id, err := decodeCursor(in.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		before = id
+	}
+
+	//Every source is read up to one tweet more than the page, to know whether there is a next page
+	var tweets []tweet
+	err := s.store.View(func(tx *Tx) error {
+		if _, ok := tx.User(in.Username); !ok {
+			return errNoSuchUser
+		}
+		collect := func() func(t tweet) error {
+			n := 0
+			return func(t tweet) error {
+				//Tweets of deleted accounts are hidden until the accounts are restored
+				if _, ok := tx.ActiveUser(t.Author); !ok {
+					return nil
+				}
+				//Tweets of muted users stay in the timeline and are skipped as it is read
+				if tx.HidesTweet(in.Username, t) {
+					return nil
+				}
+				tweets = append(tweets, t)
+				n++
+				if n > limit {
+					return errStopIteration
+				}
+				return nil
+			}
+		}
+		if err := tx.ForEachTimelineTweet(in.Username, before, collect()); err != nil {
+			return err
+		}
+		//Pull the tweets of followed authors which were not fanned out
+		return tx.ForEachFollow(in.Username, func(followed string) error {
+			return tx.ForEachUnfannedTweet(followed, before, collect())
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(tweets, func(i, j int) bool { return tweets[i].ID > tweets[j].ID })
+	//A user following itself finds its unfanned tweets in its own timeline too
+	merged := tweets[:0]
+	for i, t := range tweets {
+		if i == 0 || t.ID != tweets[i-1].ID {
+			merged = append(merged, t)
+		}
+	}
+	tweets = merged
+	response := &pb.HomeTimelineResponse{}
+	if len(tweets) > limit {
+		tweets = tweets[:limit]
+		response.NextCursor = encodeCursor(tweets[limit-1].ID)
+	}
+	for _, t := range tweets {
+		response.Tweets = append(response.Tweets, tweetToProto(t))
+	}
+	s.decorateTweets(in.Username, response.Tweets)
+	return response, nil
+}
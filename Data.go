@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"golang.org/x/net/context"
 	pb "twitter-distributed/utils/ProtoDef"
@@ -33,14 +34,76 @@ func userExists(uname string) bool {
 	}
 }
 
-func addTweet(username string, tweettext string) {
+func addTweet(request *pb.AddTweetRequest) error {
 	if isServerAlive() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
-		_, err := rpcCaller.AddTweet(ctx, &pb.AddTweetRequest{Username: username, TweetText: tweettext, Broadcast: true})
+		request.Broadcast = true
+		_, err := rpcCaller.AddTweet(ctx, request)
 		if err != nil {
 			fmt.Println("Debug: tweet addition failed", err)
 		}
+		return err
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return errors.New("server is down")
+	}
+}
+
+//Add a tweet replying to the tweet with the given ID
+func replyTweet(username string, id int64, tweettext string) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.AddTweet(ctx, &pb.AddTweetRequest{Username: username, TweetText: tweettext, ReplyTo: id, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: reply failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Get the tree of replies below a tweet
+func getConversation(username string, id int64) *pb.ConversationReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetConversation(ctx, &pb.ConversationRequest{Username: username, TweetId: id})
+		if err != nil {
+			fmt.Println("Debug: GetConversation rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Delete one of the user's tweets
+func deleteTweet(username string, id int64) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.DeleteTweet(ctx, &pb.DeleteTweetRequest{Username: username, TweetId: id, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: tweet deletion failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Replace the text of one of the user's tweets
+func editTweet(username string, id int64, tweettext string) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.EditTweet(ctx, &pb.EditTweetRequest{Username: username, TweetId: id, TweetText: tweettext, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: tweet edit failed", err)
+		}
 	} else {
 		debugPrint("Debug: Primary server down, cant process requests")
 	}
@@ -50,7 +113,7 @@ func getMyTweets(username string) *pb.OwnTweetsReply {
 	if isServerAlive() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
-		reply, err := rpcCaller.OwnTweets(ctx, &pb.OwnTweetsRequest{Username: username})
+		reply, err := rpcCaller.OwnTweets(ctx, &pb.OwnTweetsRequest{Username: username, Viewer: username})
 		if err != nil {
 			fmt.Println(err)
 			return nil
@@ -62,6 +125,460 @@ func getMyTweets(username string) *pb.OwnTweetsReply {
 	}
 }
 
+//Retweet a tweet, or quote it if tweettext is not empty
+func retweet(username string, id int64, tweettext string) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.Retweet(ctx, &pb.RetweetRequest{Username: username, TweetId: id, TweetText: tweettext, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: Retweet rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Like a tweet, or take the like back
+func likeTweet(username string, id int64, like bool) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.LikeRequest{Username: username, TweetId: id, Broadcast: true}
+		var err error
+		if like {
+			_, err = rpcCaller.LikeTweet(ctx, request)
+		} else {
+			_, err = rpcCaller.UnlikeTweet(ctx, request)
+		}
+		if err != nil {
+			fmt.Println("Debug: like rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Get a page of the tweets the user liked
+func getLikedTweets(username string, cursor string) *pb.HomeTimelineResponse {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.ListLikedTweets(ctx, &pb.HomeTimelineRequest{Username: username, Cursor: cursor})
+		if err != nil {
+			fmt.Println("Debug: ListLikedTweets rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Get a page of the user's notifications, cursor is empty for the newest ones
+func listNotifications(username string, cursor string, limit int) *pb.NotificationsReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.ListNotifications(ctx, &pb.NotificationsRequest{Username: username, Cursor: cursor, Limit: int32(limit)})
+		if err != nil {
+			fmt.Println("Debug: ListNotifications rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Mark the user's notifications up to the one with the given ID as read
+func markNotificationsRead(username string, upTo int64) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.MarkNotificationsRead(ctx, &pb.MarkNotificationsReadRequest{Username: username, UpTo: upTo, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: MarkNotificationsRead rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Get a page of the tweets using a hashtag, cursor is empty for the newest tweets
+func getHashtagTweets(username string, hashtag string, cursor string) *pb.HomeTimelineResponse {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.TweetsByHashtag(ctx, &pb.HashtagRequest{Username: username, Hashtag: hashtag, Cursor: cursor})
+		if err != nil {
+			fmt.Println("Debug: TweetsByHashtag rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Search tweets, author, since and until are left out of the search if they are not set
+func searchTweets(username string, query string, author string, since int64, until int64, cursor string) *pb.SearchReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.SearchRequest{Username: username, Query: query, Author: author, Since: since, Until: until, Cursor: cursor}
+		reply, err := rpcCaller.SearchTweets(ctx, request)
+		if err != nil {
+			fmt.Println("Debug: SearchTweets rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Send a direct message to a conversation, or to the recipients if id is 0. Returns the conversation's ID
+func sendMessage(username string, recipients []string, id int64, text string) (int64, error) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.SendMessageRequest{Username: username, Recipients: recipients, ConversationId: id, Text: text, Broadcast: true}
+		reply, err := rpcCaller.SendMessage(ctx, request)
+		if err != nil {
+			fmt.Println("Debug: SendMessage rpc failed", err)
+			return 0, err
+		}
+		return reply.ConversationId, nil
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return 0, errors.New("server is down")
+	}
+}
+
+//Get a page of the user's direct message conversations, latest first
+func listConversations(username string, cursor string) *pb.ListConversationsReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.ListConversations(ctx, &pb.ListConversationsRequest{Username: username, Cursor: cursor})
+		if err != nil {
+			fmt.Println("Debug: ListConversations rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Get a page of the messages of a conversation, newest first
+func getConversationMessages(username string, id int64, cursor string) *pb.ConversationMessagesReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetConversationMessages(ctx, &pb.ConversationMessagesRequest{Username: username, ConversationId: id, Cursor: cursor})
+		if err != nil {
+			fmt.Println("Debug: GetConversationMessages rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Set whether users the user does not follow can message it
+func updateDMSettings(username string, open bool) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.UpdateDMSettings(ctx, &pb.DMSettingsRequest{Username: username, OpenDms: open, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: UpdateDMSettings rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Get the trending hashtags
+func getTrends() *pb.TrendsReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetTrends(ctx, &pb.TrendsRequest{})
+		if err != nil {
+			fmt.Println("Debug: GetTrends rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Get a page of the user's home timeline, cursor is empty for the newest tweets
+func getHomeTimeline(username string, cursor string) *pb.HomeTimelineResponse {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.HomeTimeline(ctx, &pb.HomeTimelineRequest{Username: username, Cursor: cursor})
+		if err != nil {
+			fmt.Println("Debug: HomeTimeline rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Stop following a user
+func unfollowUser(username string, unfollow string) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.UnfollowUser(ctx, &pb.UnfollowUserRequest{SelfUsername: username, ToUnfollowUsername: unfollow, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: UnfollowUser rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Get a user's profile as seen by viewer
+func getProfile(username string, viewer string) *pb.Profile {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetProfile(ctx, &pb.ProfileRequest{Username: username, Viewer: viewer})
+		if err != nil {
+			fmt.Println("Debug: GetProfile rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Upload an avatar image, the returned blob ID is set as the avatar with updateProfile
+func uploadAvatar(username string, data []byte) (string, error) {
+	if isServerAlive() {
+		//the image is stored on every server before the call returns
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		reply, err := rpcCaller.UploadAvatar(ctx, &pb.UploadAvatarRequest{Username: username, Data: data, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: UploadAvatar rpc failed", err)
+			return "", err
+		}
+		return reply.Id, nil
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return "", errors.New("server is down")
+	}
+}
+
+//Replace the user's profile, the avatar is the blob ID of an uploaded avatar or empty to keep the current one
+func updateProfile(request *pb.UpdateProfileRequest) error {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		request.Broadcast = true
+		_, err := rpcCaller.UpdateProfile(ctx, request)
+		if err != nil {
+			fmt.Println("Debug: UpdateProfile rpc failed", err)
+		}
+		return err
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return errors.New("server is down")
+	}
+}
+
+//Upload an image to be attached to a tweet
+func uploadMedia(username string, data []byte) (*pb.Media, error) {
+	if isServerAlive() {
+		//the image is stored on every server before the call returns
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		reply, err := rpcCaller.UploadMedia(ctx, &pb.UploadMediaRequest{Username: username, Data: data, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: UploadMedia rpc failed", err)
+			return nil, err
+		}
+		return reply.Media, nil
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil, errors.New("server is down")
+	}
+}
+
+//Get a stored image
+func getBlob(id string) *pb.BlobReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetBlob(ctx, &pb.BlobRequest{Id: id})
+		if err != nil {
+			fmt.Println("Debug: GetBlob rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Get the tweets of a user as seen by viewer
+func getUserTweets(username string, viewer string) (*pb.OwnTweetsReply, error) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		return rpcCaller.OwnTweets(ctx, &pb.OwnTweetsRequest{Username: username, Viewer: viewer})
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil, errors.New("server is down")
+	}
+}
+
+//Set whether only approved followers see the user's tweets
+func setProtected(username string, protected bool) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.SetProtected(ctx, &pb.ProtectRequest{Username: username, Protected: protected, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: SetProtected rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Approve or reject a request of requester to follow the user
+func answerFollowRequest(username string, requester string, approve bool) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.FollowRequestDecision{Username: username, Requester: requester, Broadcast: true}
+		var err error
+		if approve {
+			_, err = rpcCaller.ApproveFollowRequest(ctx, request)
+		} else {
+			_, err = rpcCaller.RejectFollowRequest(ctx, request)
+		}
+		if err != nil {
+			fmt.Println("Debug: answering follow request failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Get a page of the users waiting for the user to approve their follow
+func listFollowRequests(username string, cursor string) *pb.ListFollowsResponse {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.ListFollowRequests(ctx, &pb.ListFollowsRequest{Username: username, Cursor: cursor})
+		if err != nil {
+			fmt.Println("Debug: ListFollowRequests rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Block, unblock, mute or unmute a user
+func setRelation(username string, target string, relation string, on bool) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.BlockRequest{Username: username, Target: target, Broadcast: true}
+		var err error
+		switch {
+		case relation == "block" && on:
+			_, err = rpcCaller.BlockUser(ctx, request)
+		case relation == "block":
+			_, err = rpcCaller.UnblockUser(ctx, request)
+		case on:
+			_, err = rpcCaller.MuteUser(ctx, request)
+		default:
+			_, err = rpcCaller.UnmuteUser(ctx, request)
+		}
+		if err != nil {
+			fmt.Println("Debug: "+relation+" rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Get a page of the users the user blocked, or of the users it muted
+func listBlocked(username string, muted bool, cursor string) *pb.ListFollowsResponse {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.ListFollowsRequest{Username: username, Cursor: cursor}
+		var reply *pb.ListFollowsResponse
+		var err error
+		if muted {
+			reply, err = rpcCaller.ListMuted(ctx, request)
+		} else {
+			reply, err = rpcCaller.ListBlocked(ctx, request)
+		}
+		if err != nil {
+			fmt.Println("Debug: listing blocked users failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Get a page of the users the user follows, or of its followers. limit 0 uses the default page size
+func listFollows(username string, followers bool, cursor string, limit int) *pb.ListFollowsResponse {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.ListFollowsRequest{Username: username, Cursor: cursor, Limit: int32(limit)}
+		var reply *pb.ListFollowsResponse
+		var err error
+		if followers {
+			reply, err = rpcCaller.ListFollowers(ctx, request)
+		} else {
+			reply, err = rpcCaller.ListFollowing(ctx, request)
+		}
+		if err != nil {
+			fmt.Println("Debug: listing follows failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
 //Delete a user account
 func deleteUser(username string) int {
 	if isServerAlive() {
@@ -81,6 +598,195 @@ func deleteUser(username string) int {
 	}
 }
 
+//tweetTime formats the creation time of a tweet for display
+func tweetTime(t *pb.Tweet) string {
+	return time.Unix(0, t.Timestamp*int64(time.Millisecond)).Format("Jan 2 15:04")
+}
+
+//Queue a tweet to be published later
+func scheduleTweet(request *pb.ScheduleTweetRequest) error {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request.Broadcast = true
+		_, err := rpcCaller.ScheduleTweet(ctx, request)
+		if err != nil {
+			fmt.Println("Debug: ScheduleTweet rpc failed", err)
+		}
+		return err
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return errors.New("server is down")
+	}
+}
+
+//Get the user's scheduled tweets, the next one to be published first
+func getScheduledTweets(username string) *pb.ListScheduledReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.ListScheduledTweets(ctx, &pb.ListScheduledRequest{Username: username})
+		if err != nil {
+			fmt.Println("Debug: ListScheduledTweets rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Remove a tweet from the user's queue before it is published
+func cancelScheduledTweet(username string, id int64) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.CancelScheduledTweet(ctx, &pb.CancelScheduledRequest{Username: username, Id: id, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: CancelScheduledTweet rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Create a list owned by the user, returns the ID of the new list
+func createList(username string, name string, description string, private bool) (int64, error) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.CreateList(ctx, &pb.CreateListRequest{Username: username, Name: name, Description: description,
+			Private: private, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: CreateList rpc failed", err)
+			return 0, err
+		}
+		return reply.ListId, nil
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return 0, errors.New("server is down")
+	}
+}
+
+//Delete one of the user's lists
+func deleteList(username string, id int64) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.DeleteList(ctx, &pb.DeleteListRequest{Username: username, ListId: id, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: DeleteList rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Add an account to or remove it from one of the user's lists
+func setListMember(username string, id int64, member string, add bool) error {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		request := &pb.ListMemberRequest{Username: username, ListId: id, Member: member, Broadcast: true}
+		var err error
+		if add {
+			_, err = rpcCaller.AddListMember(ctx, request)
+		} else {
+			_, err = rpcCaller.RemoveListMember(ctx, request)
+		}
+		if err != nil {
+			fmt.Println("Debug: List member rpc failed", err)
+		}
+		return err
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return errors.New("server is down")
+	}
+}
+
+//Get the lists a user owns which the viewer may see
+func getLists(username string, viewer string) *pb.GetListsReply {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetLists(ctx, &pb.GetListsRequest{Username: username, Viewer: viewer})
+		if err != nil {
+			fmt.Println("Debug: GetLists rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Get a list with its members
+func getList(id int64, viewer string) *pb.UserList {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetList(ctx, &pb.GetListRequest{ListId: id, Viewer: viewer})
+		if err != nil {
+			fmt.Println("Debug: GetList rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Get a page of the tweets of the members of a list, cursor is empty for the newest ones
+func getListTimeline(id int64, viewer string, cursor string) *pb.HomeTimelineResponse {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		reply, err := rpcCaller.GetListTimeline(ctx, &pb.ListTimelineRequest{ListId: id, Viewer: viewer, Cursor: cursor})
+		if err != nil {
+			fmt.Println("Debug: GetListTimeline rpc failed", err)
+			return nil
+		}
+		return reply
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return nil
+	}
+}
+
+//Vote for an option of the poll of a tweet
+func votePoll(username string, id int64, option int32) {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.VotePoll(ctx, &pb.VotePollRequest{Username: username, TweetId: id, Option: option, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: VotePoll rpc failed", err)
+		}
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+	}
+}
+
+//Restore a deleted account, returns true on success
+func restoreUser(username string, password string) bool {
+	if isServerAlive() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := rpcCaller.RestoreUser(ctx, &pb.Credentials{Uname: username, Pwd: password, Broadcast: true})
+		if err != nil {
+			fmt.Println("Debug: Restore User RPC failed", err)
+			return false
+		}
+		return true
+	} else {
+		debugPrint("Debug: Primary server down, cant process requests")
+		return false
+	}
+}
+
 func GetPrimary(view int, nservers int) int {
 	return view % nservers
 }
@@ -1,12 +1,17 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"html/template"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
+	"strconv"
 	"os"
+	"regexp"
 	"time"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -16,6 +21,9 @@ import (
 const (
 	//address     = "localhost:50051"
 	defaultName = "world"
+
+	maxUploadSize   = 5 << 20 // largest image attached to a tweet
+	maxTweetUploads = 4
 )
 
 // Global variables for View Change
@@ -27,6 +35,12 @@ var primaryServerIndex int
 
 var rpcCaller pb.GreeterClient
 
+//hashtags in tweets link to the tweets using them
+var hashtagPattern = regexp.MustCompile(`#(\w+)`)
+
+//usernames only have word characters, the backend rejects any other username
+var usernamePattern = regexp.MustCompile(`^\w+$`)
+
 //Handler to deal with only / requests.
 func sayhelloName(w http.ResponseWriter, r *http.Request) {
 	fmt.Println("Debug: Sayhello Handler")
@@ -75,6 +89,10 @@ func loginHandler(w http.ResponseWriter, r *http.Request) {
 				if err.Error() == "Wrong Password" {
 					http.Redirect(w, r, "/login", http.StatusSeeOther)
 					return
+				} else if strings.Contains(err.Error(), "account is deleted") {
+					//The account can still be restored
+					http.Redirect(w, r, "/restore", http.StatusSeeOther)
+					return
 				} else {
 					http.Redirect(w, r, "/registration", http.StatusSeeOther)
 					return
@@ -121,6 +139,13 @@ func registrationHandler(w http.ResponseWriter, r *http.Request) {
 			http.Redirect(w, r, "/registration", http.StatusSeeOther)
 			return
 		}
+		if !usernamePattern.MatchString(r.Form["username"][0]) {
+			if debugon {
+				fmt.Println("Debug: Invalid Username")
+			}
+			http.Redirect(w, r, "/registration", http.StatusSeeOther)
+			return
+		}
 
 		//Check if Primary server is alive
 		if isServerAlive() {
@@ -174,63 +199,785 @@ func homeHandler(w http.ResponseWriter, r *http.Request) {
 		t, _ := template.ParseFiles("home.html")
 		t.Execute(w, nil)
 	} else {
-		//Post: submission of new tweet. Save the tweet and then display Home.
-		r.ParseForm()
-		addTweet(username, r.Form["tweet"][0])
+		//Post: submission of new tweet. Upload the attached images, save the tweet and then display Home.
+		r.ParseMultipartForm(maxTweetUploads * maxUploadSize)
+		media, err := uploadTweetMedia(username, r)
+		if err == nil {
+			request := &pb.AddTweetRequest{Username: username, TweetText: r.FormValue("tweet"), Media: media}
+			//empty choices are left out, a tweet without any has no poll
+			for _, option := range r.Form["option"] {
+				if strings.TrimSpace(option) != "" {
+					request.PollOptions = append(request.PollOptions, option)
+				}
+			}
+			if len(request.PollOptions) != 0 {
+				minutes, _ := strconv.ParseInt(r.FormValue("duration"), 10, 64)
+				request.PollDuration = minutes * int64(time.Minute/time.Millisecond)
+			}
+			if r.FormValue("publishat") == "" {
+				err = addTweet(request)
+			} else {
+				err = scheduleTweetAt(request, r.FormValue("publishat"))
+			}
+		}
 		t, _ := template.ParseFiles("home.html")
 		t.Execute(w, nil)
+		if err != nil {
+			fmt.Fprint(w, "<p>Tweet not posted: "+template.HTMLEscapeString(err.Error())+"</p>")
+		}
+
+	}
+
+	//Display follower and following counts
+	following := listFollows(username, false, "", 1)
+	followers := listFollows(username, true, "", 1)
+	if following != nil && followers != nil {
+		fmt.Fprintf(w, "<a href=following>%d following</a> <a href=followers>%d followers</a> <a href=liked>Liked tweets</a> <a href=search>Search</a> <a href=messages>Messages</a> <a href=blocked>Blocked users</a> <a href=scheduled>Scheduled tweets</a> <a href=lists>Lists</a> <a href=u/"+username+">Profile</a>", following.Count, followers.Count)
+	}
+	if notifications := listNotifications(username, "", 1); notifications != nil {
+		fmt.Fprintf(w, " <a href=notifications>Notifications (%d)</a>", notifications.Unread)
+	}
+	fmt.Fprint(w, "<br /><br />")
+
+	//Display the trending hashtags as a sidebar
+	if trends := getTrends(); trends != nil && len(trends.Trends) != 0 {
+		fmt.Fprint(w, "<div style=\"float:right;width:15em\"><b>Trending</b><br />")
+		for _, trend := range trends.Trends {
+			fmt.Fprintf(w, "<a href=hashtag?tag=%s>#%s</a> <small>%d tweets</small><br />", trend.Hashtag, trend.Hashtag, trend.Tweets)
+		}
+		fmt.Fprint(w, "</div>")
+	}
+
+	//Display the home timeline, one page at a time
+	cursor := r.URL.Query().Get("cursor")
+	timeline := getHomeTimeline(username, cursor)
+	if timeline == nil {
+		return
+	}
+	if len(timeline.Tweets) != 0 {
+		fmt.Fprint(w, "<h>Here is your timeline, "+username+":<h><br />")
+	} else if cursor == "" {
+		fmt.Fprint(w, "<h>What's on your mind? Make a tweet, or discover some users to follow !<h><br />")
+	}
+	for _, dispTweet := range timeline.Tweets {
+		displayTweet(w, dispTweet, username)
+	}
+	if timeline.NextCursor != "" {
+		fmt.Fprintf(w, "<a href=home?cursor=%s>Older tweets</a>", timeline.NextCursor)
+	}
+
+}
+
+//scheduleTweetAt queues a new tweet for the local time picked in the form
+func scheduleTweetAt(request *pb.AddTweetRequest, publishAt string) error {
+	at, err := time.ParseInLocation("2006-01-02T15:04", publishAt, time.Local)
+	if err != nil {
+		return errors.New("scheduled time is not valid")
+	}
+	return scheduleTweet(&pb.ScheduleTweetRequest{Username: request.Username, TweetText: request.TweetText,
+		PublishAt: at.UnixNano() / int64(time.Millisecond), Media: request.Media, PollOptions: request.PollOptions,
+		PollDuration: request.PollDuration})
+}
+
+//uploadTweetMedia uploads the images attached to a new tweet
+func uploadTweetMedia(username string, r *http.Request) ([]*pb.Media, error) {
+	if r.MultipartForm == nil {
+		return nil, nil
+	}
+	var media []*pb.Media
+	files := r.MultipartForm.File["media"]
+	if len(files) > maxTweetUploads {
+		return nil, fmt.Errorf("a tweet has at most %d images", maxTweetUploads)
+	}
+	for _, header := range files {
+		//an empty file input still sends a part without a file name
+		if header.Filename == "" && header.Size == 0 {
+			continue
+		}
+		if header.Size > maxUploadSize {
+			return nil, fmt.Errorf("%s is larger than %d MB", header.Filename, maxUploadSize>>20)
+		}
+		file, err := header.Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := ioutil.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(http.DetectContentType(data), "image/") {
+			return nil, fmt.Errorf("%s is not an image", header.Filename)
+		}
+		m, err := uploadMedia(username, data)
+		if err != nil {
+			return nil, err
+		}
+		media = append(media, m)
+	}
+	return media, nil
+}
+
+//linkHashtags escapes the text of a tweet for the page and links its hashtags
+func linkHashtags(text string) string {
+	html := ""
+	last := 0
+	for _, m := range hashtagPattern.FindAllStringSubmatchIndex(text, -1) {
+		tag := text[m[2]:m[3]]
+		html += template.HTMLEscapeString(text[last:m[0]]) + "<a href=hashtag?tag=" + tag + ">#" + tag + "</a>"
+		last = m[1]
+	}
+	return html + template.HTMLEscapeString(text[last:])
+}
+
+//Print a tweet with its edit history. The user's own tweets get delete and edit controls
+func displayTweet(w http.ResponseWriter, dispTweet *pb.Tweet, username string) {
+	if dispTweet.RetweetOf != 0 {
+		//A retweet shows the original tweet under the name of the user who retweeted it
+		fmt.Fprint(w, "<small>"+template.HTMLEscapeString(dispTweet.Author)+" retweeted</small>")
+		if dispTweet.Original == nil {
+			fmt.Fprint(w, "<p><i>This tweet is unavailable</i></p>")
+			return
+		}
+		displayTweet(w, dispTweet.Original, username)
+		return
+	}
+	fmt.Fprint(w, "<p>")
+	author := template.HTMLEscapeString(dispTweet.Author)
+	fmt.Fprint(w, "<b><a href=u/"+author+">"+author+"</a></b><br/>")
+	if dispTweet.ReplyTo != 0 {
+		fmt.Fprintf(w, "<small><a href=thread?id=%d>in reply to</a></small><br/>", dispTweet.ReplyTo)
+	}
+	fmt.Fprint(w, linkHashtags(dispTweet.Text))
+	for _, m := range dispTweet.Media {
+		//thumbnails link to the full image
+		fmt.Fprint(w, "<br/><a href=blob/"+m.Id+"><img src=blob/"+m.Thumbnail+"></a>")
+	}
+	if dispTweet.Poll != nil {
+		displayPoll(w, dispTweet, username)
+	}
+	if dispTweet.QuoteOf != 0 {
+		//The quoted tweet is shown inside the quote
+		fmt.Fprint(w, "<blockquote>")
+		if dispTweet.Original == nil {
+			fmt.Fprint(w, "<i>This tweet is unavailable</i>")
+		} else {
+			fmt.Fprint(w, "<b>"+template.HTMLEscapeString(dispTweet.Original.Author)+"</b><br/>"+template.HTMLEscapeString(dispTweet.Original.Text))
+		}
+		fmt.Fprint(w, "</blockquote>")
+	}
+	fmt.Fprint(w, "<br/><small>"+tweetTime(dispTweet))
+	if dispTweet.EditedAt != 0 {
+		fmt.Fprint(w, " (edited)")
+	}
+	fmt.Fprint(w, "</small>")
+	for _, previous := range dispTweet.History {
+		fmt.Fprint(w, "<br/><small><s>"+template.HTMLEscapeString(previous.Text)+"</s></small>")
+	}
+	if dispTweet.Liked {
+		fmt.Fprintf(w, "<br/>%d likes <a href=like?id=%d&undo=1>Unlike</a>", dispTweet.Likes, dispTweet.Id)
+	} else {
+		fmt.Fprintf(w, "<br/>%d likes <a href=like?id=%d>Like</a>", dispTweet.Likes, dispTweet.Id)
+	}
+	fmt.Fprintf(w, " %d retweets <a href=retweet?id=%d>Retweet</a> %d quotes", dispTweet.Retweets, dispTweet.Id, dispTweet.Quotes)
+	fmt.Fprintf(w, " <a href=thread?id=%d>%d replies</a>", dispTweet.Id, dispTweet.Replies)
+	fmt.Fprintf(w, "<form method=post action=retweet><input type=hidden name=id value=%d>", dispTweet.Id)
+	fmt.Fprint(w, "<input type=text name=tweet><input type=submit value=Quote></form>")
+	if dispTweet.Author == username {
+		fmt.Fprintf(w, "<br/><a href=deleteTweet?id=%d>Delete</a>", dispTweet.Id)
+		fmt.Fprintf(w, "<form method=post action=editTweet><input type=hidden name=id value=%d>", dispTweet.Id)
+		fmt.Fprint(w, "<input type=text name=tweet><input type=submit value=Edit></form>")
+	}
+	fmt.Fprint(w, "</p>")
+}
+
+//displayPoll shows the choices of an open poll the user can vote in as links, and the results otherwise
+func displayPoll(w http.ResponseWriter, dispTweet *pb.Tweet, username string) {
+	poll := dispTweet.Poll
+	total := int32(0)
+	for _, option := range poll.Options {
+		total += option.Votes
+	}
+	showResults := poll.Closed || poll.Voted || dispTweet.Author == username
+	for i, option := range poll.Options {
+		text := template.HTMLEscapeString(option.Text)
+		if !showResults {
+			fmt.Fprintf(w, "<br/><a href=vote?id=%d&option=%d>%s</a>", dispTweet.Id, i, text)
+			continue
+		}
+		percent := int32(0)
+		if total != 0 {
+			percent = option.Votes * 100 / total
+		}
+		if poll.Voted && poll.Choice == int32(i) {
+			text = "<b>" + text + "</b>"
+		}
+		fmt.Fprintf(w, "<br/>%s %d%% (%d)", text, percent, option.Votes)
+	}
+	if poll.Closed {
+		fmt.Fprintf(w, "<br/><small>%d votes, final results</small>", total)
+	} else {
+		expires := time.Unix(0, poll.ExpiresAt*int64(time.Millisecond)).Format("Jan 2 15:04")
+		fmt.Fprintf(w, "<br/><small>%d votes, open until %s</small>", total, expires)
+	}
+}
+
+//Vote handler, votes in the poll of a tweet and goes back to the page the user came from
+func voteHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: vote handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	option, optionErr := strconv.ParseInt(r.URL.Query().Get("option"), 10, 32)
+	if err == nil && optionErr == nil {
+		votePoll(cookie.Value, id, int32(option))
+	}
+	back := r.Referer()
+	if back == "" {
+		back = "/home"
+	}
+	http.Redirect(w, r, back, http.StatusSeeOther)
+}
+
+//Like handler, likes or unlikes a tweet and goes back to the page the user came from
+func likeHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: like handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err == nil {
+		likeTweet(cookie.Value, id, r.URL.Query().Get("undo") == "")
+	}
+	back := r.Referer()
+	if back == "" {
+		back = "/home"
+	}
+	http.Redirect(w, r, back, http.StatusSeeOther)
+}
+
+//Retweet handler, retweets a tweet on GET and quotes it with the posted text on POST
+func retweetHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: retweet handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	r.ParseForm()
+	id, err := strconv.ParseInt(r.Form.Get("id"), 10, 64)
+	if err == nil {
+		retweet(cookie.Value, id, r.Form.Get("tweet"))
+	}
+	http.Redirect(w, r, "/home", http.StatusSeeOther)
+}
+
+//Thread page handler, shows the replies below a tweet. A post adds a reply to one of the tweets shown
+func threadHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: thread handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+	r.ParseForm()
+	if r.Method == "POST" {
+		replyID, err := strconv.ParseInt(r.Form.Get("reply"), 10, 64)
+		if err == nil && r.Form.Get("tweet") != "" {
+			replyTweet(username, replyID, r.Form.Get("tweet"))
+		}
+		http.Redirect(w, r, "/thread?id="+r.Form.Get("id"), http.StatusSeeOther)
+		return
+	}
 
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	id, err := strconv.ParseInt(r.Form.Get("id"), 10, 64)
+	if err != nil {
+		return
 	}
+	conversation := getConversation(username, id)
+	if conversation == nil {
+		fmt.Fprint(w, "<p><i>This tweet is unavailable</i></p>")
+		return
+	}
+	displayConversation(w, conversation.Root, id, username)
+}
 
-	//Display self tweets
-	tweets := getMyTweets(username).TweetList
-	if len(tweets) != 0 {
-		fmt.Fprint(w, "<h>Here are your tweets, "+username+":<h><br />")
+//Print a tweet of a conversation with a reply form, followed by its replies indented below it
+func displayConversation(w http.ResponseWriter, node *pb.ConversationNode, rootID int64, username string) {
+	if node.Tweet == nil {
+		fmt.Fprint(w, "<p><i>This tweet is unavailable</i></p>")
 	} else {
-		fmt.Fprint(w, "<h>What's on your mind? Make a tweet ! <h><br />")
+		displayTweet(w, node.Tweet, username)
+		fmt.Fprintf(w, "<form method=post action=thread><input type=hidden name=id value=%d>", rootID)
+		fmt.Fprintf(w, "<input type=hidden name=reply value=%d>", node.Tweet.Id)
+		fmt.Fprint(w, "<input type=text name=tweet><input type=submit value=Reply></form>")
+	}
+	fmt.Fprint(w, "<div style=\"margin-left:2em\">")
+	for _, reply := range node.Replies {
+		displayConversation(w, reply, rootID, username)
 	}
-	for _, dispTweet := range tweets {
+	fmt.Fprint(w, "</div>")
+}
+
+//Notifications page handler, the notifications shown are marked as read
+func notificationsHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: notifications handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	cursor := r.URL.Query().Get("cursor")
+	notifications := listNotifications(username, cursor, 0)
+	if notifications == nil {
+		return
+	}
+	fmt.Fprintf(w, "<h>Notifications, %d unread:<h><br />", notifications.Unread)
+	for _, n := range notifications.Notifications {
 		fmt.Fprint(w, "<p>")
-		fmt.Fprint(w, dispTweet.Text)
+		if !n.Read {
+			fmt.Fprint(w, "<b>new</b> ")
+		}
+		actor := template.HTMLEscapeString(n.Actor)
+		switch n.Kind {
+		case "mention":
+			fmt.Fprint(w, actor+" mentioned you")
+		case "follow":
+			fmt.Fprint(w, actor+" followed you")
+		case "like":
+			fmt.Fprint(w, actor+" liked your tweet")
+		case "reply":
+			fmt.Fprint(w, actor+" replied to your tweet")
+		case "follow_request":
+			query := url.QueryEscape(n.Actor)
+			fmt.Fprint(w, actor+" asked to follow you <a href=followers?approve="+query+">Approve</a> <a href=followers?reject="+query+">Reject</a>")
+		case "follow_approved":
+			fmt.Fprint(w, actor+" approved your follow request")
+		}
 		fmt.Fprint(w, "</p>")
+		if n.Tweet != nil {
+			displayTweet(w, n.Tweet, username)
+		}
+	}
+	if notifications.NextCursor != "" {
+		fmt.Fprintf(w, "<a href=notifications?cursor=%s>Older notifications</a>", notifications.NextCursor)
+	}
+	//Notifications are read once the newest one shown was seen
+	if cursor == "" && len(notifications.Notifications) != 0 && notifications.Unread != 0 {
+		markNotificationsRead(username, notifications.Notifications[0].Id)
 	}
+}
 
-	//Check if Primary server is alive
-	if isServerAlive() {
-		// Initiate RPC call to get all the friends tweets
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-		defer cancel()
-		reply, err := rpcCaller.GetFriendsTweets(ctx, &pb.GetFriendsTweetsRequest{Username: username})
-		if err == nil {
-			fmt.Println("Successful GetFriendsTweets RPC")
-			allFriendsTweets := reply.FriendsTweets
-			fmt.Fprint(w, "<br /><br />")
-			if len(allFriendsTweets) != 0 {
-				fmt.Fprint(w, "<h>Here are your friends tweets:<h><br />")
-			} else {
-				fmt.Fprint(w, "<h>Go right ahead, Discover some users to follow<h><br />")
+//Hashtag page handler, shows the tweets using a hashtag
+func hashtagHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: hashtag handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	tag := template.HTMLEscapeString(r.URL.Query().Get("tag"))
+	tweets := getHashtagTweets(username, tag, r.URL.Query().Get("cursor"))
+	if tweets == nil {
+		return
+	}
+	fmt.Fprint(w, "<h>Tweets with #"+tag+":<h><br />")
+	for _, dispTweet := range tweets.Tweets {
+		displayTweet(w, dispTweet, username)
+	}
+	if tweets.NextCursor != "" {
+		fmt.Fprintf(w, "<a href=hashtag?tag=%s&cursor=%s>Older tweets</a>", tag, tweets.NextCursor)
+	}
+}
+
+//Search page handler, shows the search form and the tweets matching it. Dates are days, until includes the day
+func searchHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: search handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+	query := r.URL.Query()
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	fmt.Fprint(w, "<form method=get action=search>")
+	fmt.Fprintf(w, "<input type=text name=q placeholder=\"words, &quot;a phrase&quot;, pre*\" value=\"%s\">", template.HTMLEscapeString(query.Get("q")))
+	fmt.Fprintf(w, " from <input type=text name=author value=\"%s\">", template.HTMLEscapeString(query.Get("author")))
+	fmt.Fprintf(w, " since <input type=date name=since value=\"%s\">", template.HTMLEscapeString(query.Get("since")))
+	fmt.Fprintf(w, " until <input type=date name=until value=\"%s\">", template.HTMLEscapeString(query.Get("until")))
+	fmt.Fprint(w, " <input type=submit value=Search></form>")
+	if query.Get("q") == "" {
+		return
+	}
+
+	var since, until int64
+	if day, err := time.Parse("2006-01-02", query.Get("since")); err == nil {
+		since = day.UnixNano() / int64(time.Millisecond)
+	}
+	if day, err := time.Parse("2006-01-02", query.Get("until")); err == nil {
+		until = day.Add(24*time.Hour).UnixNano() / int64(time.Millisecond)
+	}
+	results := searchTweets(username, query.Get("q"), query.Get("author"), since, until, query.Get("cursor"))
+	if results == nil {
+		fmt.Fprint(w, "<p>Nothing found</p>")
+		return
+	}
+	fmt.Fprintf(w, "<h>%d tweets found:<h><br />", results.Total)
+	for _, dispTweet := range results.Tweets {
+		displayTweet(w, dispTweet, username)
+	}
+	if results.NextCursor != "" {
+		query.Set("cursor", results.NextCursor)
+		fmt.Fprintf(w, "<a href=\"search?%s\">More results</a>", template.HTMLEscapeString(query.Encode()))
+	}
+}
+
+//Direct messages handler. Without an id it shows the user's conversations, with one the messages of that
+//conversation. Posts send a message or change who can message the user
+func messagesHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: messages handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+	r.ParseForm()
+	id, _ := strconv.ParseInt(r.Form.Get("id"), 10, 64)
+
+	if r.Method == "POST" {
+		if open := r.Form.Get("open"); open != "" {
+			updateDMSettings(username, open == "1")
+			http.Redirect(w, r, "/messages", http.StatusSeeOther)
+			return
+		}
+		var recipients []string
+		for _, recipient := range strings.Split(r.Form.Get("to"), ",") {
+			if recipient = strings.TrimSpace(recipient); recipient != "" {
+				recipients = append(recipients, recipient)
 			}
+		}
+		sent, err := sendMessage(username, recipients, id, r.Form.Get("text"))
+		if err == nil {
+			http.Redirect(w, r, "/messages?id="+strconv.FormatInt(sent, 10), http.StatusSeeOther)
+			return
+		}
+		t, _ := template.ParseFiles("Home.html")
+		t.Execute(w, nil)
+		fmt.Fprint(w, "<p>Message not sent: "+template.HTMLEscapeString(err.Error())+"</p><a href=messages>Back to messages</a>")
+		return
+	}
 
-			for _, eachUser := range allFriendsTweets {
-				//Print Friend's name
-				fmt.Fprint(w, "<br/>"+eachUser.Username.Username+":"+"<br/>")
-				for _, eachTweet := range eachUser.Tweets {
-					//Print Friend's all the tweets
-					fmt.Fprint(w, "<p>")
-					fmt.Fprint(w, eachTweet.Text)
-					fmt.Fprint(w, "</p>")
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	if id == 0 {
+		fmt.Fprint(w, "<h>Your messages:<h><br />")
+		fmt.Fprint(w, "<form method=post action=messages>To <input type=text name=to placeholder=\"user1, user2\">")
+		fmt.Fprint(w, " <input type=text name=text><input type=submit value=Send></form>")
+		fmt.Fprint(w, "<form method=post action=messages>Who can message you: ")
+		fmt.Fprint(w, "<button name=open value=1>Anyone</button> <button name=open value=0>Users you follow</button></form>")
+		conversations := listConversations(username, r.Form.Get("cursor"))
+		if conversations == nil {
+			return
+		}
+		for _, c := range conversations.Conversations {
+			var others []string
+			for _, member := range c.Members {
+				if member != username {
+					others = append(others, template.HTMLEscapeString(member))
 				}
 			}
-			return
-		} else {
-			fmt.Println("GetFriendsTweets RPC failed", reply, err)
-			http.Redirect(w, r, "/home", http.StatusSeeOther)
+			fmt.Fprintf(w, "<p><a href=messages?id=%d><b>%s</b></a><br/>", c.Id, strings.Join(others, ", "))
+			if c.LastMessage != nil {
+				fmt.Fprint(w, template.HTMLEscapeString(c.LastMessage.Sender)+": "+template.HTMLEscapeString(c.LastMessage.Text))
+			}
+			fmt.Fprint(w, "</p>")
+		}
+		if conversations.NextCursor != "" {
+			fmt.Fprintf(w, "<a href=messages?cursor=%s>Older conversations</a>", conversations.NextCursor)
+		}
+		return
+	}
+
+	messages := getConversationMessages(username, id, r.Form.Get("cursor"))
+	if messages == nil {
+		fmt.Fprint(w, "<p><i>This conversation is unavailable</i></p>")
+		return
+	}
+	var members []string
+	for _, member := range messages.Members {
+		members = append(members, template.HTMLEscapeString(member))
+	}
+	fmt.Fprint(w, "<h>Conversation with "+strings.Join(members, ", ")+":<h><br />")
+	if messages.NextCursor != "" {
+		fmt.Fprintf(w, "<a href=messages?id=%d&cursor=%s>Older messages</a>", id, messages.NextCursor)
+	}
+	//Messages come newest first, a conversation reads oldest first
+	for i := len(messages.Messages) - 1; i >= 0; i-- {
+		m := messages.Messages[i]
+		fmt.Fprint(w, "<p><b>"+template.HTMLEscapeString(m.Sender)+"</b> <small>"+time.Unix(0, m.Timestamp*int64(time.Millisecond)).Format("Jan 2 15:04")+"</small><br/>"+template.HTMLEscapeString(m.Text)+"</p>")
+	}
+	fmt.Fprintf(w, "<form method=post action=messages><input type=hidden name=id value=%d>", id)
+	fmt.Fprint(w, "<input type=text name=text><input type=submit value=Send></form>")
+}
+
+//Liked tweets page handler
+func likedHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: liked handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	liked := getLikedTweets(username, r.URL.Query().Get("cursor"))
+	if liked == nil {
+		return
+	}
+	fmt.Fprint(w, "<h>Tweets you liked:<h><br />")
+	for _, dispTweet := range liked.Tweets {
+		displayTweet(w, dispTweet, username)
+	}
+	if liked.NextCursor != "" {
+		fmt.Fprintf(w, "<a href=liked?cursor=%s>Older tweets</a>", liked.NextCursor)
+	}
+}
+
+//Scheduled tweets page handler, lists the user's queue. The cancel parameter removes a tweet from the queue
+func scheduledHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: scheduled handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+	if id, err := strconv.ParseInt(r.URL.Query().Get("cancel"), 10, 64); err == nil {
+		cancelScheduledTweet(username, id)
+		http.Redirect(w, r, "/scheduled", http.StatusSeeOther)
+		return
+	}
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	queue := getScheduledTweets(username)
+	if queue == nil {
+		return
+	}
+	fmt.Fprint(w, "<h>Your scheduled tweets:<h><br />")
+	if len(queue.Scheduled) == 0 {
+		fmt.Fprint(w, "<p>No tweets are scheduled</p>")
+	}
+	for _, st := range queue.Scheduled {
+		publishAt := time.Unix(0, st.PublishAt*int64(time.Millisecond)).Format("Jan 2 15:04")
+		fmt.Fprint(w, "<p><small>To be published "+publishAt+"</small><br/>"+template.HTMLEscapeString(st.TweetText))
+		for _, m := range st.Media {
+			fmt.Fprint(w, "<br/><img src=blob/"+m.Thumbnail+">")
+		}
+		for _, option := range st.PollOptions {
+			fmt.Fprint(w, "<br/>"+template.HTMLEscapeString(option))
+		}
+		fmt.Fprintf(w, "<br/><a href=scheduled?cancel=%d>Cancel</a></p>", st.Id)
+	}
+}
+
+//Lists page handler, shows the user's lists with a form to create one, or the public lists of the user parameter
+func listsHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: lists handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+	r.ParseForm()
+
+	if r.Method == "POST" {
+		id, err := createList(username, r.Form.Get("name"), r.Form.Get("description"), r.Form.Get("private") != "")
+		if err == nil {
+			http.Redirect(w, r, "/list?id="+strconv.FormatInt(id, 10), http.StatusSeeOther)
 			return
 		}
+		t, _ := template.ParseFiles("Home.html")
+		t.Execute(w, nil)
+		fmt.Fprint(w, "<p>List not created: "+template.HTMLEscapeString(err.Error())+"</p><a href=lists>Back to your lists</a>")
+		return
+	}
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	owner := r.Form.Get("user")
+	if owner == "" {
+		owner = username
+	}
+	lists := getLists(owner, username)
+	if lists == nil {
+		fmt.Fprint(w, "<h>This account doesn't exist<h>")
+		return
+	}
+	if owner == username {
+		fmt.Fprint(w, "<h>Your lists:<h><br />")
+		fmt.Fprint(w, "<form method=post action=lists>Name <input type=text name=name> Description <input type=text name=description>")
+		fmt.Fprint(w, " <input type=checkbox name=private value=1> Private <input type=submit value=\"Create list\"></form>")
 	} else {
-		debugPrint("Debug: Primary server down, cant process requests")
+		fmt.Fprint(w, "<h>Lists of "+template.HTMLEscapeString(owner)+":<h><br />")
 	}
+	if len(lists.Lists) == 0 {
+		fmt.Fprint(w, "<p>No lists</p>")
+	}
+	for _, l := range lists.Lists {
+		fmt.Fprintf(w, "<p><a href=list?id=%d><b>%s</b></a>", l.Id, template.HTMLEscapeString(l.Name))
+		if l.Private {
+			fmt.Fprint(w, " <small>(private)</small>")
+		}
+		fmt.Fprintf(w, " <small>%d members</small><br/>%s</p>", l.MemberCount, template.HTMLEscapeString(l.Description))
+	}
+}
 
+//List page handler, shows a list's members and the timeline of their tweets. The owner adds members with the form,
+//the remove parameter removes a member and the delete parameter deletes the list
+func listHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: list handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+	r.ParseForm()
+	id, _ := strconv.ParseInt(r.Form.Get("id"), 10, 64)
+	page := "/list?id=" + strconv.FormatInt(id, 10)
+
+	if r.Form.Get("delete") != "" {
+		deleteList(username, id)
+		http.Redirect(w, r, "/lists", http.StatusSeeOther)
+		return
+	}
+	if member := r.Form.Get("remove"); member != "" {
+		setListMember(username, id, member, false)
+		http.Redirect(w, r, page, http.StatusSeeOther)
+		return
+	}
+	if r.Method == "POST" {
+		err := setListMember(username, id, strings.TrimSpace(r.Form.Get("member")), true)
+		if err == nil {
+			http.Redirect(w, r, page, http.StatusSeeOther)
+			return
+		}
+		t, _ := template.ParseFiles("Home.html")
+		t.Execute(w, nil)
+		fmt.Fprint(w, "<p>Member not added: "+template.HTMLEscapeString(err.Error())+"</p><a href="+page[1:]+">Back to the list</a>")
+		return
+	}
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	l := getList(id, username)
+	if l == nil {
+		fmt.Fprint(w, "<h>This list doesn't exist<h>")
+		return
+	}
+	esc := template.HTMLEscapeString
+	fmt.Fprint(w, "<h2>"+esc(l.Name)+"</h2><small>by <a href=u/"+esc(url.PathEscape(l.Owner))+">@"+esc(l.Owner)+"</a>")
+	if l.Private {
+		fmt.Fprint(w, " (private)")
+	}
+	fmt.Fprint(w, "</small><br/>")
+	if l.Description != "" {
+		fmt.Fprint(w, "<p>"+esc(l.Description)+"</p>")
+	}
+	fmt.Fprintf(w, "<p>%d members: ", l.MemberCount)
+	for _, member := range l.Members {
+		fmt.Fprint(w, "<a href=u/"+esc(url.PathEscape(member))+">"+esc(member)+"</a>")
+		if l.Owner == username {
+			fmt.Fprintf(w, " <small><a href=list?id=%d&remove=%s>Remove</a></small>", id, url.QueryEscape(member))
+		}
+		fmt.Fprint(w, " ")
+	}
+	fmt.Fprint(w, "</p>")
+	if l.Owner == username {
+		fmt.Fprintf(w, "<form method=post action=list><input type=hidden name=id value=%d>", id)
+		fmt.Fprint(w, "<input type=text name=member placeholder=username><input type=submit value=\"Add member\"></form>")
+		fmt.Fprintf(w, "<a href=list?id=%d&delete=1>Delete list</a>", id)
+	}
+
+	timeline := getListTimeline(id, username, r.Form.Get("cursor"))
+	if timeline == nil {
+		return
+	}
+	for _, dispTweet := range timeline.Tweets {
+		displayTweet(w, dispTweet, username)
+	}
+	if timeline.NextCursor != "" {
+		fmt.Fprintf(w, "<a href=list?id=%d&cursor=%s>Older tweets</a>", id, timeline.NextCursor)
+	}
+}
+
+//Delete Tweet handler
+func deleteTweetHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: delete tweet handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err == nil {
+		deleteTweet(cookie.Value, id)
+	}
+	http.Redirect(w, r, "/home", http.StatusSeeOther)
+}
+
+//Edit Tweet handler
+func editTweetHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: edit tweet handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	r.ParseForm()
+	id, err := strconv.ParseInt(r.Form.Get("id"), 10, 64)
+	if err == nil && r.Form.Get("tweet") != "" {
+		editTweet(cookie.Value, id, r.Form.Get("tweet"))
+	}
+	http.Redirect(w, r, "/home", http.StatusSeeOther)
 }
 
 //Logout handler
@@ -274,7 +1021,9 @@ func usersHandler(w http.ResponseWriter, r *http.Request) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 			defer cancel()
 			reply, err := rpcCaller.FollowUser(ctx, &pb.FollowUserRequest{SelfUsername: username, ToFollowUsername: toFollow, Broadcast: true})
-			if err == nil {
+			if err == nil && reply.Pending {
+				fmt.Fprintf(w, "<p>%s protects their tweets, your follow request was sent</p>", toFollow)
+			} else if err == nil {
 				fmt.Println("User " + username + " successfully followed user " + toFollow)
 			} else {
 				fmt.Println("FollowUser RPC failed", reply, err)
@@ -299,7 +1048,12 @@ func usersHandler(w http.ResponseWriter, r *http.Request) {
 			allUsersToFollow := reply.UsersToFollowList
 			for _, eachUser := range allUsersToFollow {
 				//Adding all the users to follow on the website
-				fmt.Fprintf(w, "%s <a href=users?tofollow=%s>Follow</a>", eachUser.Username, eachUser.Username)
+				if eachUser.Protected {
+					fmt.Fprintf(w, "%s (protected) <a href=users?tofollow=%s>Request to follow</a>", eachUser.Username, eachUser.Username)
+				} else {
+					fmt.Fprintf(w, "%s <a href=users?tofollow=%s>Follow</a>", eachUser.Username, eachUser.Username)
+				}
+				fmt.Fprintf(w, " <a href=blocked?block=%s>Block</a> <a href=blocked?mute=%s>Mute</a>", eachUser.Username, eachUser.Username)
 				fmt.Fprint(w, "</br>")
 			}
 		} else {
@@ -313,6 +1067,285 @@ func usersHandler(w http.ResponseWriter, r *http.Request) {
 
 }
 
+//Following page handler, lists the users the user follows with links to unfollow them
+func followingHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: following handler")
+	showFollows(w, r, false)
+}
+
+//Followers page handler
+func followersHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: followers handler")
+	showFollows(w, r, true)
+}
+
+func showFollows(w http.ResponseWriter, r *http.Request, followers bool) {
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+
+	toUnfollow := r.URL.Query().Get("unfollow")
+	if toUnfollow != "" && !followers {
+		unfollowUser(username, toUnfollow)
+		http.Redirect(w, r, "/following", http.StatusSeeOther)
+		return
+	}
+	//Follow requests and the protection of the user's tweets are managed from the followers page
+	if followers {
+		r.ParseForm()
+		if protected := r.Form.Get("protected"); r.Method == "POST" && protected != "" {
+			setProtected(username, protected == "1")
+			http.Redirect(w, r, "/followers", http.StatusSeeOther)
+			return
+		}
+		if requester := r.Form.Get("approve"); requester != "" {
+			answerFollowRequest(username, requester, true)
+			http.Redirect(w, r, "/followers", http.StatusSeeOther)
+			return
+		}
+		if requester := r.Form.Get("reject"); requester != "" {
+			answerFollowRequest(username, requester, false)
+			http.Redirect(w, r, "/followers", http.StatusSeeOther)
+			return
+		}
+	}
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	reply := listFollows(username, followers, r.URL.Query().Get("cursor"), 0)
+	if reply == nil {
+		return
+	}
+	page := "following"
+	if followers {
+		page = "followers"
+		fmt.Fprint(w, "<form method=post action=followers>Who can see your tweets: ")
+		fmt.Fprint(w, "<button name=protected value=0>Anyone</button> <button name=protected value=1>Followers you approve</button></form>")
+		if requests := listFollowRequests(username, r.URL.Query().Get("rcursor")); requests != nil && requests.Count != 0 {
+			fmt.Fprintf(w, "<h>%d users asked to follow you<h><br/>", requests.Count)
+			for _, eachUser := range requests.Users {
+				fmt.Fprintf(w, "%s <a href=followers?approve=%s>Approve</a> <a href=followers?reject=%s>Reject</a></br>", eachUser.Username, eachUser.Username, eachUser.Username)
+			}
+			if requests.NextCursor != "" {
+				fmt.Fprintf(w, "<a href=followers?rcursor=%s>More requests</a><br/>", requests.NextCursor)
+			}
+			fmt.Fprint(w, "<br/>")
+		}
+		fmt.Fprintf(w, "<h>%d users follow you<h><br/>", reply.Count)
+	} else {
+		fmt.Fprintf(w, "<h>You follow %d users<h><br/>", reply.Count)
+	}
+	for _, eachUser := range reply.Users {
+		fmt.Fprint(w, eachUser.Username)
+		if !followers {
+			fmt.Fprintf(w, " <a href=following?unfollow=%s>Unfollow</a>", eachUser.Username)
+		}
+		fmt.Fprintf(w, " <a href=blocked?block=%s>Block</a> <a href=blocked?mute=%s>Mute</a>", eachUser.Username, eachUser.Username)
+		fmt.Fprint(w, "</br>")
+	}
+	if reply.NextCursor != "" {
+		fmt.Fprintf(w, "<a href=%s?cursor=%s>More</a>", page, reply.NextCursor)
+	}
+}
+
+//Profile page handler for /u/{username}. The user's own profile page also edits the profile
+func profileHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: profile handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+	profileUser := strings.TrimPrefix(r.URL.Path, "/u/")
+	if profileUser == "" {
+		http.Redirect(w, r, "/u/"+username, http.StatusSeeOther)
+		return
+	}
+
+	if r.Method == "POST" && profileUser == username {
+		r.ParseMultipartForm(1 << 20)
+		request := &pb.UpdateProfileRequest{Username: username, DisplayName: r.FormValue("name"), Bio: r.FormValue("bio"),
+			Location: r.FormValue("location"), Website: r.FormValue("website"), RemoveAvatar: r.FormValue("removeavatar") != ""}
+		var err error
+		if file, _, formErr := r.FormFile("avatar"); formErr == nil {
+			data, _ := ioutil.ReadAll(file)
+			file.Close()
+			request.Avatar, err = uploadAvatar(username, data)
+		}
+		if err == nil {
+			err = updateProfile(request)
+		}
+		if err != nil {
+			t, _ := template.ParseFiles("Home.html")
+			t.Execute(w, nil)
+			fmt.Fprint(w, "<p>Profile not saved: "+template.HTMLEscapeString(err.Error())+"</p><a href=u/"+username+">Back to your profile</a>")
+			return
+		}
+		http.Redirect(w, r, "/u/"+username, http.StatusSeeOther)
+		return
+	}
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	profile := getProfile(profileUser, username)
+	if profile == nil {
+		fmt.Fprint(w, "<h>This account doesn't exist<h>")
+		return
+	}
+	esc := template.HTMLEscapeString
+	if profile.Avatar != "" {
+		fmt.Fprint(w, "<img src=blob/"+profile.Avatar+" width=96 height=96><br/>")
+	}
+	name := profile.DisplayName
+	if name == "" {
+		name = profile.Username
+	}
+	fmt.Fprint(w, "<h2>"+esc(name)+"</h2><small>@"+profile.Username+"</small>")
+	if profile.Protected {
+		fmt.Fprint(w, " <small>(protected)</small>")
+	}
+	if profile.FollowsViewer {
+		fmt.Fprint(w, " <small>follows you</small>")
+	}
+	fmt.Fprint(w, "<br/>")
+	if profile.Bio != "" {
+		fmt.Fprint(w, "<p>"+esc(profile.Bio)+"</p>")
+	}
+	if profile.Location != "" {
+		fmt.Fprint(w, esc(profile.Location)+" ")
+	}
+	if profile.Website != "" {
+		fmt.Fprint(w, "<a href=\""+esc(profile.Website)+"\" rel=nofollow>"+esc(profile.Website)+"</a>")
+	}
+	fmt.Fprintf(w, "<p>%d tweets %d following %d followers <a href=lists?user=%s>Lists</a></p>", profile.Tweets, profile.Following,
+		profile.Followers, profileUser)
+
+	if profileUser == username {
+		fmt.Fprint(w, "<form method=post action=u/"+username+" enctype=multipart/form-data>")
+		fmt.Fprint(w, "Name <input type=text name=name value=\""+esc(profile.DisplayName)+"\"><br/>")
+		fmt.Fprint(w, "Bio <input type=text name=bio value=\""+esc(profile.Bio)+"\"><br/>")
+		fmt.Fprint(w, "Location <input type=text name=location value=\""+esc(profile.Location)+"\"><br/>")
+		fmt.Fprint(w, "Website <input type=text name=website value=\""+esc(profile.Website)+"\"><br/>")
+		fmt.Fprint(w, "Avatar <input type=file name=avatar accept=image/png,image/jpeg,image/gif>")
+		fmt.Fprint(w, " <input type=checkbox name=removeavatar value=1> Remove avatar<br/>")
+		fmt.Fprint(w, "<input type=submit value=\"Save profile\"></form>")
+	} else if profile.Blocked {
+		fmt.Fprint(w, "<p>You can't follow or see the tweets of this account</p>")
+		return
+	} else if profile.Followed {
+		fmt.Fprint(w, "<a href=following?unfollow="+profileUser+">Unfollow</a>")
+	} else if profile.Requested {
+		fmt.Fprint(w, "Follow requested <a href=following?unfollow="+profileUser+">Cancel</a>")
+	} else {
+		fmt.Fprint(w, "<a href=users?tofollow="+profileUser+">Follow</a>")
+	}
+	if profileUser != username {
+		fmt.Fprintf(w, " <a href=blocked?block=%s>Block</a> <a href=blocked?mute=%s>Mute</a>", profileUser, profileUser)
+	}
+
+	tweets, err := getUserTweets(profileUser, username)
+	if err != nil {
+		if profile.Protected {
+			fmt.Fprint(w, "<p>These tweets are protected. Only approved followers see them</p>")
+		}
+		return
+	}
+	for i := len(tweets.TweetList) - 1; i >= 0; i-- {
+		displayTweet(w, tweets.TweetList[i], username)
+	}
+}
+
+//Blob handler, serves the stored images at /blob/{id}
+func blobHandler(w http.ResponseWriter, r *http.Request) {
+	blob := getBlob(strings.TrimPrefix(r.URL.Path, "/blob/"))
+	if blob == nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", blob.ContentType)
+	//a blob's ID is the hash of its content, it never changes
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Write(blob.Data)
+}
+
+//Blocked users page handler, lists the blocked and the muted users. block, unblock, mute and unmute parameters
+//change them first
+func blockedHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: blocked handler")
+	cookie, ok := r.Cookie("username")
+	if ok != nil {
+		//Cookie does not exist re-direct to login
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	username := cookie.Value
+
+	query := r.URL.Query()
+	for _, change := range []struct {
+		param    string
+		relation string
+		on       bool
+	}{{"block", "block", true}, {"unblock", "block", false}, {"mute", "mute", true}, {"unmute", "mute", false}} {
+		if target := query.Get(change.param); target != "" {
+			setRelation(username, target, change.relation, change.on)
+			http.Redirect(w, r, "/blocked", http.StatusSeeOther)
+			return
+		}
+	}
+
+	t, _ := template.ParseFiles("Home.html")
+	t.Execute(w, nil)
+	for _, muted := range []bool{false, true} {
+		cursorParam, action, label := "cursor", "unblock", "Unblock"
+		if muted {
+			cursorParam, action, label = "mcursor", "unmute", "Unmute"
+		}
+		reply := listBlocked(username, muted, query.Get(cursorParam))
+		if reply == nil {
+			return
+		}
+		if muted {
+			fmt.Fprintf(w, "<h>You muted %d users<h><br/>", reply.Count)
+		} else {
+			fmt.Fprintf(w, "<h>You blocked %d users<h><br/>", reply.Count)
+		}
+		for _, eachUser := range reply.Users {
+			fmt.Fprintf(w, "%s <a href=blocked?%s=%s>%s</a></br>", eachUser.Username, action, eachUser.Username, label)
+		}
+		if reply.NextCursor != "" {
+			fmt.Fprintf(w, "<a href=blocked?%s=%s>More</a><br/>", cursorParam, reply.NextCursor)
+		}
+		fmt.Fprint(w, "<br/>")
+	}
+}
+
+//Restore Account handler, a deleted account can be restored until its grace period ends
+func restoreHandler(w http.ResponseWriter, r *http.Request) {
+	fmt.Println("Debug: restore handler")
+	fmt.Println("method:", r.Method)
+
+	if r.Method == "GET" {
+		fmt.Fprint(w, "<h>Your account was deleted. Log in again to restore it<h><br/>")
+		fmt.Fprint(w, "<form method=post action=restore>")
+		fmt.Fprint(w, "<input type=text name=username placeholder=Username><br/>")
+		fmt.Fprint(w, "<input type=password name=password placeholder=Password><br/>")
+		fmt.Fprint(w, "<input type=submit value=Restore></form>")
+		return
+	}
+	r.ParseForm()
+	if restoreUser(r.Form.Get("username"), r.Form.Get("password")) {
+		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		return
+	}
+	http.Redirect(w, r, "/restore", http.StatusSeeOther)
+}
+
 //Delete Account handler
 func deleteHandler(w http.ResponseWriter, r *http.Request) {
 	fmt.Println("Debug: Invoked Delete Handler")
@@ -359,7 +1392,7 @@ func main() {
 
 	// Creating RPC greeter clients for all the servers
 	for index, port := range peers {
-		conn, err := grpc.Dial(port, grpc.WithInsecure())
+		conn, err := grpc.Dial(port, grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxUploadSize+1<<20)))
 		if err != nil {
 			log.Fatalf("did not connect: %v to port %s", err, port)
 		}
@@ -394,7 +1427,27 @@ func main() {
 	http.HandleFunc("/home", homeHandler)
 	http.HandleFunc("/logout", logoutHandler)
 	http.HandleFunc("/users", usersHandler)
+	http.HandleFunc("/following", followingHandler)
+	http.HandleFunc("/followers", followersHandler)
 	http.HandleFunc("/deleteAccount", deleteHandler)
+	http.HandleFunc("/restore", restoreHandler)
+	http.HandleFunc("/deleteTweet", deleteTweetHandler)
+	http.HandleFunc("/like", likeHandler)
+	http.HandleFunc("/vote", voteHandler)
+	http.HandleFunc("/scheduled", scheduledHandler)
+	http.HandleFunc("/lists", listsHandler)
+	http.HandleFunc("/list", listHandler)
+	http.HandleFunc("/retweet", retweetHandler)
+	http.HandleFunc("/thread", threadHandler)
+	http.HandleFunc("/notifications", notificationsHandler)
+	http.HandleFunc("/hashtag", hashtagHandler)
+	http.HandleFunc("/search", searchHandler)
+	http.HandleFunc("/messages", messagesHandler)
+	http.HandleFunc("/liked", likedHandler)
+	http.HandleFunc("/blocked", blockedHandler)
+	http.HandleFunc("/u/", profileHandler)
+	http.HandleFunc("/blob/", blobHandler)
+	http.HandleFunc("/editTweet", editTweetHandler)
 	http.HandleFunc("/favicon.ico", faviconHandler)
 
 	//Our server listens on this port
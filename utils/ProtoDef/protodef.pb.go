@@ -18,22 +18,104 @@ It has these top-level messages:
 	AddTweetRequest
 	AddTweetReply
 	Tweet
+	Poll
+	PollOption
+	VotePollRequest
+	VotePollReply
+	ScheduleTweetRequest
+	ScheduleTweetReply
+	ScheduledTweet
+	ListScheduledRequest
+	ListScheduledReply
+	CancelScheduledRequest
+	CancelScheduledReply
+	CreateListRequest
+	CreateListReply
+	DeleteListRequest
+	DeleteListReply
+	ListMemberRequest
+	ListMemberReply
+	GetListsRequest
+	GetListsReply
+	GetListRequest
+	UserList
+	ListTimelineRequest
+	Media
+	RetweetRequest
+	RetweetReply
+	ConversationRequest
+	ConversationNode
+	ConversationReply
+	TweetEdit
+	DeleteTweetRequest
+	DeleteTweetReply
+	EditTweetRequest
+	EditTweetReply
+	LikeRequest
+	LikeReply
+	Notification
+	NotificationsRequest
+	NotificationsReply
+	MarkNotificationsReadRequest
+	MarkNotificationsReadReply
 	OwnTweetsReply
 	OwnTweetsRequest
 	DeleteReply
+	RestoreReply
 	User
 	UsersToFollowRequest
 	UsersToFollowResponse
 	FollowUserRequest
 	FollowUserResponse
+	UnfollowUserRequest
+	UnfollowUserResponse
+	UpdateProfileRequest
+	UpdateProfileReply
+	ProfileRequest
+	Profile
+	BlobRequest
+	BlobReply
+	UploadAvatarRequest
+	UploadAvatarReply
+	UploadMediaRequest
+	UploadMediaReply
+	FollowRequestDecision
+	FollowRequestReply
+	ProtectRequest
+	ProtectReply
+	BlockRequest
+	BlockReply
+	ListFollowsRequest
+	ListFollowsResponse
 	GetFriendsTweetsRequest
 	UsersAllTweets
 	GetFriendsTweetsResponse
+	HomeTimelineRequest
+	HomeTimelineResponse
+	HashtagRequest
+	TrendsRequest
+	Trend
+	TrendsReply
+	SearchRequest
+	SearchReply
+	DirectMessage
+	DMConversation
+	SendMessageRequest
+	SendMessageReply
+	ListConversationsRequest
+	ListConversationsReply
+	ConversationMessagesRequest
+	ConversationMessagesReply
+	DMSettingsRequest
+	DMSettingsReply
 	PrepareArgs
 	PrepareReply
 	RecoveryArgs
 	RecoveryReply
+	LogEntry
 	UserData
+	PollVote
+	Blob
 	ViewChangeArgs
 	ViewChangeReply
 	StartViewArgs
@@ -44,6 +126,16 @@ It has these top-level messages:
 	HeartBeatResponse
 	PromptViewChangeArgs
 	PromptViewChangeReply
+	LogHashArgs
+	LogHashReply
+	CompareLogsArgs
+	CompareLogsReply
+	LogComparison
+	StateDigestArgs
+	StateDigestReply
+	MerkleTree
+	StateRangeArgs
+	StateRangeReply
 */
 package helloworld
 
@@ -105,6 +197,8 @@ type Credentials struct {
 	Uname     string `protobuf:"bytes,1,opt,name=uname" json:"uname,omitempty"`
 	Pwd       string `protobuf:"bytes,2,opt,name=pwd" json:"pwd,omitempty"`
 	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+	Purge     bool   `protobuf:"varint,4,opt,name=purge" json:"purge,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
 }
 
 func (m *Credentials) Reset()                    { *m = Credentials{} }
@@ -133,6 +227,20 @@ func (m *Credentials) GetBroadcast() bool {
 	return false
 }
 
+func (m *Credentials) GetPurge() bool {
+	if m != nil {
+		return m.Purge
+	}
+	return false
+}
+
+func (m *Credentials) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 type RegisterReply struct {
 	Message string `protobuf:"bytes,1,opt,name=message" json:"message,omitempty"`
 }
@@ -198,9 +306,16 @@ func (m *UserExistsRequest) GetUsername() string {
 }
 
 type AddTweetRequest struct {
-	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
-	TweetText string `protobuf:"bytes,2,opt,name=tweet_text,json=tweetText" json:"tweet_text,omitempty"`
-	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+	Username     string   `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetText    string   `protobuf:"bytes,2,opt,name=tweet_text,json=tweetText" json:"tweet_text,omitempty"`
+	Broadcast    bool     `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+	TweetId      int64    `protobuf:"varint,4,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+	Timestamp    int64    `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	ReplyTo      int64    `protobuf:"varint,6,opt,name=reply_to,json=replyTo" json:"reply_to,omitempty"`
+	Media        []*Media `protobuf:"bytes,7,rep,name=media" json:"media,omitempty"`
+	PollOptions  []string `protobuf:"bytes,8,rep,name=poll_options,json=pollOptions" json:"poll_options,omitempty"`
+	PollDuration int64    `protobuf:"varint,9,opt,name=poll_duration,json=pollDuration" json:"poll_duration,omitempty"`
+	ScheduledId  int64    `protobuf:"varint,10,opt,name=scheduled_id,json=scheduledId" json:"scheduled_id,omitempty"`
 }
 
 func (m *AddTweetRequest) Reset()                    { *m = AddTweetRequest{} }
@@ -229,8 +344,58 @@ func (m *AddTweetRequest) GetBroadcast() bool {
 	return false
 }
 
+func (m *AddTweetRequest) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+func (m *AddTweetRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AddTweetRequest) GetReplyTo() int64 {
+	if m != nil {
+		return m.ReplyTo
+	}
+	return 0
+}
+
+func (m *AddTweetRequest) GetMedia() []*Media {
+	if m != nil {
+		return m.Media
+	}
+	return nil
+}
+
+func (m *AddTweetRequest) GetPollOptions() []string {
+	if m != nil {
+		return m.PollOptions
+	}
+	return nil
+}
+
+func (m *AddTweetRequest) GetPollDuration() int64 {
+	if m != nil {
+		return m.PollDuration
+	}
+	return 0
+}
+
+func (m *AddTweetRequest) GetScheduledId() int64 {
+	if m != nil {
+		return m.ScheduledId
+	}
+	return 0
+}
+
 type AddTweetReply struct {
-	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	Status  bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	TweetId int64 `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
 }
 
 func (m *AddTweetReply) Reset()                    { *m = AddTweetReply{} }
@@ -245,8 +410,33 @@ func (m *AddTweetReply) GetStatus() bool {
 	return false
 }
 
+func (m *AddTweetReply) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
 type Tweet struct {
-	Text string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	Text      string       `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	Id        int64        `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
+	Timestamp int64        `protobuf:"varint,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	Author    string       `protobuf:"bytes,4,opt,name=author" json:"author,omitempty"`
+	History   []*TweetEdit `protobuf:"bytes,5,rep,name=history" json:"history,omitempty"`
+	EditedAt  int64        `protobuf:"varint,6,opt,name=edited_at,json=editedAt" json:"edited_at,omitempty"`
+	Likes     int32        `protobuf:"varint,7,opt,name=likes" json:"likes,omitempty"`
+	Liked     bool         `protobuf:"varint,8,opt,name=liked" json:"liked,omitempty"`
+	RetweetOf int64        `protobuf:"varint,9,opt,name=retweet_of,json=retweetOf" json:"retweet_of,omitempty"`
+	QuoteOf   int64        `protobuf:"varint,10,opt,name=quote_of,json=quoteOf" json:"quote_of,omitempty"`
+	Original  *Tweet       `protobuf:"bytes,11,opt,name=original" json:"original,omitempty"`
+	Retweets  int32        `protobuf:"varint,12,opt,name=retweets" json:"retweets,omitempty"`
+	Quotes    int32        `protobuf:"varint,13,opt,name=quotes" json:"quotes,omitempty"`
+	ReplyTo   int64        `protobuf:"varint,14,opt,name=reply_to,json=replyTo" json:"reply_to,omitempty"`
+	Replies   int32        `protobuf:"varint,15,opt,name=replies" json:"replies,omitempty"`
+	Mentions  []string     `protobuf:"bytes,16,rep,name=mentions" json:"mentions,omitempty"`
+	Hashtags  []string     `protobuf:"bytes,17,rep,name=hashtags" json:"hashtags,omitempty"`
+	Media     []*Media     `protobuf:"bytes,18,rep,name=media" json:"media,omitempty"`
+	Poll      *Poll        `protobuf:"bytes,19,opt,name=poll" json:"poll,omitempty"`
 }
 
 func (m *Tweet) Reset()                    { *m = Tweet{} }
@@ -261,1013 +451,5808 @@ func (m *Tweet) GetText() string {
 	return ""
 }
 
-type OwnTweetsReply struct {
-	TweetList []*Tweet `protobuf:"bytes,1,rep,name=tweetList" json:"tweetList,omitempty"`
+func (m *Tweet) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *OwnTweetsReply) Reset()                    { *m = OwnTweetsReply{} }
-func (m *OwnTweetsReply) String() string            { return proto.CompactTextString(m) }
-func (*OwnTweetsReply) ProtoMessage()               {}
-func (*OwnTweetsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
-
-func (m *OwnTweetsReply) GetTweetList() []*Tweet {
+func (m *Tweet) GetTimestamp() int64 {
 	if m != nil {
-		return m.TweetList
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-type OwnTweetsRequest struct {
-	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+func (m *Tweet) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
 }
 
-func (m *OwnTweetsRequest) Reset()                    { *m = OwnTweetsRequest{} }
-func (m *OwnTweetsRequest) String() string            { return proto.CompactTextString(m) }
-func (*OwnTweetsRequest) ProtoMessage()               {}
-func (*OwnTweetsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
-
-func (m *OwnTweetsRequest) GetUsername() string {
+func (m *Tweet) GetHistory() []*TweetEdit {
 	if m != nil {
-		return m.Username
+		return m.History
 	}
-	return ""
+	return nil
 }
 
-type DeleteReply struct {
-	DeleteStatus bool `protobuf:"varint,1,opt,name=deleteStatus" json:"deleteStatus,omitempty"`
+func (m *Tweet) GetEditedAt() int64 {
+	if m != nil {
+		return m.EditedAt
+	}
+	return 0
 }
 
-func (m *DeleteReply) Reset()                    { *m = DeleteReply{} }
-func (m *DeleteReply) String() string            { return proto.CompactTextString(m) }
-func (*DeleteReply) ProtoMessage()               {}
-func (*DeleteReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (m *Tweet) GetLikes() int32 {
+	if m != nil {
+		return m.Likes
+	}
+	return 0
+}
 
-func (m *DeleteReply) GetDeleteStatus() bool {
+func (m *Tweet) GetLiked() bool {
 	if m != nil {
-		return m.DeleteStatus
+		return m.Liked
 	}
 	return false
 }
 
-type User struct {
-	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+func (m *Tweet) GetRetweetOf() int64 {
+	if m != nil {
+		return m.RetweetOf
+	}
+	return 0
 }
 
-func (m *User) Reset()                    { *m = User{} }
-func (m *User) String() string            { return proto.CompactTextString(m) }
-func (*User) ProtoMessage()               {}
-func (*User) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
-
-func (m *User) GetUsername() string {
+func (m *Tweet) GetQuoteOf() int64 {
 	if m != nil {
-		return m.Username
+		return m.QuoteOf
 	}
-	return ""
+	return 0
 }
 
-type UsersToFollowRequest struct {
-	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+func (m *Tweet) GetOriginal() *Tweet {
+	if m != nil {
+		return m.Original
+	}
+	return nil
 }
 
-func (m *UsersToFollowRequest) Reset()                    { *m = UsersToFollowRequest{} }
-func (m *UsersToFollowRequest) String() string            { return proto.CompactTextString(m) }
-func (*UsersToFollowRequest) ProtoMessage()               {}
-func (*UsersToFollowRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (m *Tweet) GetRetweets() int32 {
+	if m != nil {
+		return m.Retweets
+	}
+	return 0
+}
 
-func (m *UsersToFollowRequest) GetUsername() string {
+func (m *Tweet) GetQuotes() int32 {
 	if m != nil {
-		return m.Username
+		return m.Quotes
 	}
-	return ""
+	return 0
 }
 
-type UsersToFollowResponse struct {
-	UsersToFollowList []*User `protobuf:"bytes,1,rep,name=usersToFollowList" json:"usersToFollowList,omitempty"`
+func (m *Tweet) GetReplyTo() int64 {
+	if m != nil {
+		return m.ReplyTo
+	}
+	return 0
 }
 
-func (m *UsersToFollowResponse) Reset()                    { *m = UsersToFollowResponse{} }
-func (m *UsersToFollowResponse) String() string            { return proto.CompactTextString(m) }
-func (*UsersToFollowResponse) ProtoMessage()               {}
-func (*UsersToFollowResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (m *Tweet) GetReplies() int32 {
+	if m != nil {
+		return m.Replies
+	}
+	return 0
+}
 
-func (m *UsersToFollowResponse) GetUsersToFollowList() []*User {
+func (m *Tweet) GetMentions() []string {
 	if m != nil {
-		return m.UsersToFollowList
+		return m.Mentions
 	}
 	return nil
 }
 
-type FollowUserRequest struct {
-	SelfUsername     string `protobuf:"bytes,1,opt,name=selfUsername" json:"selfUsername,omitempty"`
-	ToFollowUsername string `protobuf:"bytes,2,opt,name=toFollowUsername" json:"toFollowUsername,omitempty"`
-	Broadcast        bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+func (m *Tweet) GetHashtags() []string {
+	if m != nil {
+		return m.Hashtags
+	}
+	return nil
 }
 
-func (m *FollowUserRequest) Reset()                    { *m = FollowUserRequest{} }
-func (m *FollowUserRequest) String() string            { return proto.CompactTextString(m) }
-func (*FollowUserRequest) ProtoMessage()               {}
-func (*FollowUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
-
-func (m *FollowUserRequest) GetSelfUsername() string {
+func (m *Tweet) GetMedia() []*Media {
 	if m != nil {
-		return m.SelfUsername
+		return m.Media
 	}
-	return ""
+	return nil
 }
 
-func (m *FollowUserRequest) GetToFollowUsername() string {
+func (m *Tweet) GetPoll() *Poll {
 	if m != nil {
-		return m.ToFollowUsername
+		return m.Poll
 	}
-	return ""
+	return nil
 }
 
-func (m *FollowUserRequest) GetBroadcast() bool {
+type Poll struct {
+	Options   []*PollOption `protobuf:"bytes,1,rep,name=options" json:"options,omitempty"`
+	ExpiresAt int64         `protobuf:"varint,2,opt,name=expires_at,json=expiresAt" json:"expires_at,omitempty"`
+	Closed    bool          `protobuf:"varint,3,opt,name=closed" json:"closed,omitempty"`
+	Voted     bool          `protobuf:"varint,4,opt,name=voted" json:"voted,omitempty"`
+	Choice    int32         `protobuf:"varint,5,opt,name=choice" json:"choice,omitempty"`
+}
+
+func (m *Poll) Reset()                    { *m = Poll{} }
+func (m *Poll) String() string            { return proto.CompactTextString(m) }
+func (*Poll) ProtoMessage()               {}
+func (*Poll) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *Poll) GetOptions() []*PollOption {
 	if m != nil {
-		return m.Broadcast
+		return m.Options
 	}
-	return false
+	return nil
 }
 
-type FollowUserResponse struct {
-	FollowStatus bool `protobuf:"varint,1,opt,name=followStatus" json:"followStatus,omitempty"`
+func (m *Poll) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
 
-func (m *FollowUserResponse) Reset()                    { *m = FollowUserResponse{} }
-func (m *FollowUserResponse) String() string            { return proto.CompactTextString(m) }
-func (*FollowUserResponse) ProtoMessage()               {}
-func (*FollowUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
-
-func (m *FollowUserResponse) GetFollowStatus() bool {
+func (m *Poll) GetClosed() bool {
 	if m != nil {
-		return m.FollowStatus
+		return m.Closed
 	}
 	return false
 }
 
-type GetFriendsTweetsRequest struct {
-	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+func (m *Poll) GetVoted() bool {
+	if m != nil {
+		return m.Voted
+	}
+	return false
 }
 
-func (m *GetFriendsTweetsRequest) Reset()                    { *m = GetFriendsTweetsRequest{} }
-func (m *GetFriendsTweetsRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetFriendsTweetsRequest) ProtoMessage()               {}
-func (*GetFriendsTweetsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
-
-func (m *GetFriendsTweetsRequest) GetUsername() string {
+func (m *Poll) GetChoice() int32 {
 	if m != nil {
-		return m.Username
+		return m.Choice
 	}
-	return ""
+	return 0
 }
 
-type UsersAllTweets struct {
-	Username *User    `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
-	Tweets   []*Tweet `protobuf:"bytes,2,rep,name=tweets" json:"tweets,omitempty"`
+type PollOption struct {
+	Text  string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	Votes int32  `protobuf:"varint,2,opt,name=votes" json:"votes,omitempty"`
 }
 
-func (m *UsersAllTweets) Reset()                    { *m = UsersAllTweets{} }
-func (m *UsersAllTweets) String() string            { return proto.CompactTextString(m) }
-func (*UsersAllTweets) ProtoMessage()               {}
-func (*UsersAllTweets) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (m *PollOption) Reset()                    { *m = PollOption{} }
+func (m *PollOption) String() string            { return proto.CompactTextString(m) }
+func (*PollOption) ProtoMessage()               {}
+func (*PollOption) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
-func (m *UsersAllTweets) GetUsername() *User {
+func (m *PollOption) GetText() string {
 	if m != nil {
-		return m.Username
+		return m.Text
 	}
-	return nil
+	return ""
 }
 
-func (m *UsersAllTweets) GetTweets() []*Tweet {
+func (m *PollOption) GetVotes() int32 {
 	if m != nil {
-		return m.Tweets
+		return m.Votes
 	}
-	return nil
+	return 0
 }
 
-type GetFriendsTweetsResponse struct {
-	FriendsTweets []*UsersAllTweets `protobuf:"bytes,1,rep,name=friendsTweets" json:"friendsTweets,omitempty"`
+type VotePollRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetId   int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+	Option    int32  `protobuf:"varint,3,opt,name=option" json:"option,omitempty"`
+	Broadcast bool   `protobuf:"varint,4,opt,name=broadcast" json:"broadcast,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
 }
 
-func (m *GetFriendsTweetsResponse) Reset()                    { *m = GetFriendsTweetsResponse{} }
-func (m *GetFriendsTweetsResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetFriendsTweetsResponse) ProtoMessage()               {}
-func (*GetFriendsTweetsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (m *VotePollRequest) Reset()                    { *m = VotePollRequest{} }
+func (m *VotePollRequest) String() string            { return proto.CompactTextString(m) }
+func (*VotePollRequest) ProtoMessage()               {}
+func (*VotePollRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
-func (m *GetFriendsTweetsResponse) GetFriendsTweets() []*UsersAllTweets {
+func (m *VotePollRequest) GetUsername() string {
 	if m != nil {
-		return m.FriendsTweets
+		return m.Username
 	}
-	return nil
-}
-
-type PrepareArgs struct {
-	View          int32  `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
-	PrimaryCommit int32  `protobuf:"varint,2,opt,name=PrimaryCommit" json:"PrimaryCommit,omitempty"`
-	Index         int32  `protobuf:"varint,3,opt,name=Index" json:"Index,omitempty"`
-	Entry         string `protobuf:"bytes,4,opt,name=Entry" json:"Entry,omitempty"`
+	return ""
 }
 
-func (m *PrepareArgs) Reset()                    { *m = PrepareArgs{} }
-func (m *PrepareArgs) String() string            { return proto.CompactTextString(m) }
-func (*PrepareArgs) ProtoMessage()               {}
-func (*PrepareArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
-
-func (m *PrepareArgs) GetView() int32 {
+func (m *VotePollRequest) GetTweetId() int64 {
 	if m != nil {
-		return m.View
+		return m.TweetId
 	}
 	return 0
 }
 
-func (m *PrepareArgs) GetPrimaryCommit() int32 {
+func (m *VotePollRequest) GetOption() int32 {
 	if m != nil {
-		return m.PrimaryCommit
+		return m.Option
 	}
 	return 0
 }
 
-func (m *PrepareArgs) GetIndex() int32 {
+func (m *VotePollRequest) GetBroadcast() bool {
 	if m != nil {
-		return m.Index
+		return m.Broadcast
 	}
-	return 0
+	return false
 }
 
-func (m *PrepareArgs) GetEntry() string {
+func (m *VotePollRequest) GetTimestamp() int64 {
 	if m != nil {
-		return m.Entry
+		return m.Timestamp
 	}
-	return ""
+	return 0
 }
 
-type PrepareReply struct {
-	View    int32 `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
-	Success bool  `protobuf:"varint,2,opt,name=Success" json:"Success,omitempty"`
+type VotePollReply struct {
+	Status bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	Poll   *Poll `protobuf:"bytes,2,opt,name=poll" json:"poll,omitempty"`
 }
 
-func (m *PrepareReply) Reset()                    { *m = PrepareReply{} }
-func (m *PrepareReply) String() string            { return proto.CompactTextString(m) }
-func (*PrepareReply) ProtoMessage()               {}
-func (*PrepareReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *VotePollReply) Reset()                    { *m = VotePollReply{} }
+func (m *VotePollReply) String() string            { return proto.CompactTextString(m) }
+func (*VotePollReply) ProtoMessage()               {}
+func (*VotePollReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
-func (m *PrepareReply) GetView() int32 {
+func (m *VotePollReply) GetStatus() bool {
 	if m != nil {
-		return m.View
+		return m.Status
 	}
-	return 0
+	return false
 }
 
-func (m *PrepareReply) GetSuccess() bool {
+func (m *VotePollReply) GetPoll() *Poll {
 	if m != nil {
-		return m.Success
+		return m.Poll
 	}
-	return false
+	return nil
 }
 
-type RecoveryArgs struct {
-	View   int32 `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
-	Server int32 `protobuf:"varint,2,opt,name=Server" json:"Server,omitempty"`
+type ScheduleTweetRequest struct {
+	Username     string   `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetText    string   `protobuf:"bytes,2,opt,name=tweet_text,json=tweetText" json:"tweet_text,omitempty"`
+	PublishAt    int64    `protobuf:"varint,3,opt,name=publish_at,json=publishAt" json:"publish_at,omitempty"`
+	Media        []*Media `protobuf:"bytes,4,rep,name=media" json:"media,omitempty"`
+	PollOptions  []string `protobuf:"bytes,5,rep,name=poll_options,json=pollOptions" json:"poll_options,omitempty"`
+	PollDuration int64    `protobuf:"varint,6,opt,name=poll_duration,json=pollDuration" json:"poll_duration,omitempty"`
+	Broadcast    bool     `protobuf:"varint,7,opt,name=broadcast" json:"broadcast,omitempty"`
+	Id           int64    `protobuf:"varint,8,opt,name=id" json:"id,omitempty"`
+	Timestamp    int64    `protobuf:"varint,9,opt,name=timestamp" json:"timestamp,omitempty"`
 }
 
-func (m *RecoveryArgs) Reset()                    { *m = RecoveryArgs{} }
-func (m *RecoveryArgs) String() string            { return proto.CompactTextString(m) }
-func (*RecoveryArgs) ProtoMessage()               {}
-func (*RecoveryArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (m *ScheduleTweetRequest) Reset()                    { *m = ScheduleTweetRequest{} }
+func (m *ScheduleTweetRequest) String() string            { return proto.CompactTextString(m) }
+func (*ScheduleTweetRequest) ProtoMessage()               {}
+func (*ScheduleTweetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
-func (m *RecoveryArgs) GetView() int32 {
+func (m *ScheduleTweetRequest) GetUsername() string {
 	if m != nil {
-		return m.View
+		return m.Username
 	}
-	return 0
+	return ""
 }
 
-func (m *RecoveryArgs) GetServer() int32 {
+func (m *ScheduleTweetRequest) GetTweetText() string {
 	if m != nil {
-		return m.Server
+		return m.TweetText
+	}
+	return ""
+}
+
+func (m *ScheduleTweetRequest) GetPublishAt() int64 {
+	if m != nil {
+		return m.PublishAt
 	}
 	return 0
 }
 
-type RecoveryReply struct {
-	View          int32       `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
-	Entries       []string    `protobuf:"bytes,2,rep,name=Entries" json:"Entries,omitempty"`
-	PrimaryCommit int32       `protobuf:"varint,3,opt,name=PrimaryCommit" json:"PrimaryCommit,omitempty"`
-	Success       bool        `protobuf:"varint,4,opt,name=Success" json:"Success,omitempty"`
-	Data          []*UserData `protobuf:"bytes,5,rep,name=Data" json:"Data,omitempty"`
+func (m *ScheduleTweetRequest) GetMedia() []*Media {
+	if m != nil {
+		return m.Media
+	}
+	return nil
 }
 
-func (m *RecoveryReply) Reset()                    { *m = RecoveryReply{} }
-func (m *RecoveryReply) String() string            { return proto.CompactTextString(m) }
-func (*RecoveryReply) ProtoMessage()               {}
-func (*RecoveryReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (m *ScheduleTweetRequest) GetPollOptions() []string {
+	if m != nil {
+		return m.PollOptions
+	}
+	return nil
+}
 
-func (m *RecoveryReply) GetView() int32 {
+func (m *ScheduleTweetRequest) GetPollDuration() int64 {
 	if m != nil {
-		return m.View
+		return m.PollDuration
 	}
 	return 0
 }
 
-func (m *RecoveryReply) GetEntries() []string {
+func (m *ScheduleTweetRequest) GetBroadcast() bool {
 	if m != nil {
-		return m.Entries
+		return m.Broadcast
 	}
-	return nil
+	return false
 }
 
-func (m *RecoveryReply) GetPrimaryCommit() int32 {
+func (m *ScheduleTweetRequest) GetId() int64 {
 	if m != nil {
-		return m.PrimaryCommit
+		return m.Id
 	}
 	return 0
 }
 
-func (m *RecoveryReply) GetSuccess() bool {
+func (m *ScheduleTweetRequest) GetTimestamp() int64 {
 	if m != nil {
-		return m.Success
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ScheduleTweetReply struct {
+	Status bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	Id     int64 `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ScheduleTweetReply) Reset()                    { *m = ScheduleTweetReply{} }
+func (m *ScheduleTweetReply) String() string            { return proto.CompactTextString(m) }
+func (*ScheduleTweetReply) ProtoMessage()               {}
+func (*ScheduleTweetReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *ScheduleTweetReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
 	}
 	return false
 }
 
-func (m *RecoveryReply) GetData() []*UserData {
+func (m *ScheduleTweetReply) GetId() int64 {
 	if m != nil {
-		return m.Data
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-type UserData struct {
-	Username  string   `protobuf:"bytes,1,opt,name=Username" json:"Username,omitempty"`
-	Password  string   `protobuf:"bytes,2,opt,name=Password" json:"Password,omitempty"`
-	TweetList []*Tweet `protobuf:"bytes,3,rep,name=TweetList" json:"TweetList,omitempty"`
-	Follows   []string `protobuf:"bytes,4,rep,name=Follows" json:"Follows,omitempty"`
+type ScheduledTweet struct {
+	Id           int64    `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	TweetText    string   `protobuf:"bytes,2,opt,name=tweet_text,json=tweetText" json:"tweet_text,omitempty"`
+	PublishAt    int64    `protobuf:"varint,3,opt,name=publish_at,json=publishAt" json:"publish_at,omitempty"`
+	Media        []*Media `protobuf:"bytes,4,rep,name=media" json:"media,omitempty"`
+	PollOptions  []string `protobuf:"bytes,5,rep,name=poll_options,json=pollOptions" json:"poll_options,omitempty"`
+	PollDuration int64    `protobuf:"varint,6,opt,name=poll_duration,json=pollDuration" json:"poll_duration,omitempty"`
+	CreatedAt    int64    `protobuf:"varint,7,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
 }
 
-func (m *UserData) Reset()                    { *m = UserData{} }
-func (m *UserData) String() string            { return proto.CompactTextString(m) }
-func (*UserData) ProtoMessage()               {}
-func (*UserData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (m *ScheduledTweet) Reset()                    { *m = ScheduledTweet{} }
+func (m *ScheduledTweet) String() string            { return proto.CompactTextString(m) }
+func (*ScheduledTweet) ProtoMessage()               {}
+func (*ScheduledTweet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
-func (m *UserData) GetUsername() string {
+func (m *ScheduledTweet) GetId() int64 {
 	if m != nil {
-		return m.Username
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *UserData) GetPassword() string {
+func (m *ScheduledTweet) GetTweetText() string {
 	if m != nil {
-		return m.Password
+		return m.TweetText
 	}
 	return ""
 }
 
-func (m *UserData) GetTweetList() []*Tweet {
+func (m *ScheduledTweet) GetPublishAt() int64 {
 	if m != nil {
-		return m.TweetList
+		return m.PublishAt
 	}
-	return nil
+	return 0
 }
 
-func (m *UserData) GetFollows() []string {
+func (m *ScheduledTweet) GetMedia() []*Media {
 	if m != nil {
-		return m.Follows
+		return m.Media
 	}
 	return nil
 }
 
-type ViewChangeArgs struct {
-	View int32 `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
+func (m *ScheduledTweet) GetPollOptions() []string {
+	if m != nil {
+		return m.PollOptions
+	}
+	return nil
 }
 
-func (m *ViewChangeArgs) Reset()                    { *m = ViewChangeArgs{} }
-func (m *ViewChangeArgs) String() string            { return proto.CompactTextString(m) }
-func (*ViewChangeArgs) ProtoMessage()               {}
-func (*ViewChangeArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (m *ScheduledTweet) GetPollDuration() int64 {
+	if m != nil {
+		return m.PollDuration
+	}
+	return 0
+}
 
-func (m *ViewChangeArgs) GetView() int32 {
+func (m *ScheduledTweet) GetCreatedAt() int64 {
 	if m != nil {
-		return m.View
+		return m.CreatedAt
 	}
 	return 0
 }
 
-type ViewChangeReply struct {
-	LastNormalView int32    `protobuf:"varint,1,opt,name=LastNormalView" json:"LastNormalView,omitempty"`
-	Log            []string `protobuf:"bytes,2,rep,name=Log" json:"Log,omitempty"`
-	Success        bool     `protobuf:"varint,3,opt,name=Success" json:"Success,omitempty"`
+type ListScheduledRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
 }
 
-func (m *ViewChangeReply) Reset()                    { *m = ViewChangeReply{} }
-func (m *ViewChangeReply) String() string            { return proto.CompactTextString(m) }
-func (*ViewChangeReply) ProtoMessage()               {}
-func (*ViewChangeReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (m *ListScheduledRequest) Reset()                    { *m = ListScheduledRequest{} }
+func (m *ListScheduledRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListScheduledRequest) ProtoMessage()               {}
+func (*ListScheduledRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
-func (m *ViewChangeReply) GetLastNormalView() int32 {
+func (m *ListScheduledRequest) GetUsername() string {
 	if m != nil {
-		return m.LastNormalView
+		return m.Username
 	}
-	return 0
+	return ""
+}
+
+type ListScheduledReply struct {
+	Scheduled []*ScheduledTweet `protobuf:"bytes,1,rep,name=scheduled" json:"scheduled,omitempty"`
 }
 
-func (m *ViewChangeReply) GetLog() []string {
+func (m *ListScheduledReply) Reset()                    { *m = ListScheduledReply{} }
+func (m *ListScheduledReply) String() string            { return proto.CompactTextString(m) }
+func (*ListScheduledReply) ProtoMessage()               {}
+func (*ListScheduledReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *ListScheduledReply) GetScheduled() []*ScheduledTweet {
 	if m != nil {
-		return m.Log
+		return m.Scheduled
 	}
 	return nil
 }
 
-func (m *ViewChangeReply) GetSuccess() bool {
+type CancelScheduledRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *CancelScheduledRequest) Reset()                    { *m = CancelScheduledRequest{} }
+func (m *CancelScheduledRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelScheduledRequest) ProtoMessage()               {}
+func (*CancelScheduledRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *CancelScheduledRequest) GetUsername() string {
 	if m != nil {
-		return m.Success
+		return m.Username
 	}
-	return false
+	return ""
 }
 
-type StartViewArgs struct {
-	View int32    `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
-	Log  []string `protobuf:"bytes,2,rep,name=Log" json:"Log,omitempty"`
+func (m *CancelScheduledRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *StartViewArgs) Reset()                    { *m = StartViewArgs{} }
-func (m *StartViewArgs) String() string            { return proto.CompactTextString(m) }
-func (*StartViewArgs) ProtoMessage()               {}
-func (*StartViewArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
-
-func (m *StartViewArgs) GetView() int32 {
+func (m *CancelScheduledRequest) GetBroadcast() bool {
 	if m != nil {
-		return m.View
+		return m.Broadcast
 	}
-	return 0
+	return false
 }
 
-func (m *StartViewArgs) GetLog() []string {
+type CancelScheduledReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *CancelScheduledReply) Reset()                    { *m = CancelScheduledReply{} }
+func (m *CancelScheduledReply) String() string            { return proto.CompactTextString(m) }
+func (*CancelScheduledReply) ProtoMessage()               {}
+func (*CancelScheduledReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *CancelScheduledReply) GetStatus() bool {
 	if m != nil {
-		return m.Log
+		return m.Status
 	}
-	return nil
+	return false
 }
 
-type StartViewReply struct {
+type CreateListRequest struct {
+	Username    string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	Private     bool   `protobuf:"varint,4,opt,name=private" json:"private,omitempty"`
+	Broadcast   bool   `protobuf:"varint,5,opt,name=broadcast" json:"broadcast,omitempty"`
+	ListId      int64  `protobuf:"varint,6,opt,name=list_id,json=listId" json:"list_id,omitempty"`
+	Timestamp   int64  `protobuf:"varint,7,opt,name=timestamp" json:"timestamp,omitempty"`
 }
 
-func (m *StartViewReply) Reset()                    { *m = StartViewReply{} }
-func (m *StartViewReply) String() string            { return proto.CompactTextString(m) }
-func (*StartViewReply) ProtoMessage()               {}
-func (*StartViewReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (m *CreateListRequest) Reset()                    { *m = CreateListRequest{} }
+func (m *CreateListRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateListRequest) ProtoMessage()               {}
+func (*CreateListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
-type WhoisPrimaryRequest struct {
+func (m *CreateListRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-func (m *WhoisPrimaryRequest) Reset()                    { *m = WhoisPrimaryRequest{} }
-func (m *WhoisPrimaryRequest) String() string            { return proto.CompactTextString(m) }
-func (*WhoisPrimaryRequest) ProtoMessage()               {}
-func (*WhoisPrimaryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (m *CreateListRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-type WhoIsPrimaryResponse struct {
-	Index int32 `protobuf:"varint,1,opt,name=Index" json:"Index,omitempty"`
+func (m *CreateListRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *WhoIsPrimaryResponse) Reset()                    { *m = WhoIsPrimaryResponse{} }
-func (m *WhoIsPrimaryResponse) String() string            { return proto.CompactTextString(m) }
-func (*WhoIsPrimaryResponse) ProtoMessage()               {}
-func (*WhoIsPrimaryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (m *CreateListRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
 
-func (m *WhoIsPrimaryResponse) GetIndex() int32 {
+func (m *CreateListRequest) GetBroadcast() bool {
 	if m != nil {
-		return m.Index
+		return m.Broadcast
 	}
-	return 0
+	return false
 }
 
-type HeartBeatRequest struct {
+func (m *CreateListRequest) GetListId() int64 {
+	if m != nil {
+		return m.ListId
+	}
+	return 0
 }
 
-func (m *HeartBeatRequest) Reset()                    { *m = HeartBeatRequest{} }
-func (m *HeartBeatRequest) String() string            { return proto.CompactTextString(m) }
-func (*HeartBeatRequest) ProtoMessage()               {}
-func (*HeartBeatRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (m *CreateListRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
 
-type HeartBeatResponse struct {
-	IsAlive     bool  `protobuf:"varint,1,opt,name=IsAlive" json:"IsAlive,omitempty"`
-	CurrentView int32 `protobuf:"varint,2,opt,name=currentView" json:"currentView,omitempty"`
+type CreateListReply struct {
+	Status bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	ListId int64 `protobuf:"varint,2,opt,name=list_id,json=listId" json:"list_id,omitempty"`
 }
 
-func (m *HeartBeatResponse) Reset()                    { *m = HeartBeatResponse{} }
-func (m *HeartBeatResponse) String() string            { return proto.CompactTextString(m) }
-func (*HeartBeatResponse) ProtoMessage()               {}
-func (*HeartBeatResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (m *CreateListReply) Reset()                    { *m = CreateListReply{} }
+func (m *CreateListReply) String() string            { return proto.CompactTextString(m) }
+func (*CreateListReply) ProtoMessage()               {}
+func (*CreateListReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
-func (m *HeartBeatResponse) GetIsAlive() bool {
+func (m *CreateListReply) GetStatus() bool {
 	if m != nil {
-		return m.IsAlive
+		return m.Status
 	}
 	return false
 }
 
-func (m *HeartBeatResponse) GetCurrentView() int32 {
+func (m *CreateListReply) GetListId() int64 {
 	if m != nil {
-		return m.CurrentView
+		return m.ListId
 	}
 	return 0
 }
 
-type PromptViewChangeArgs struct {
-	NewView int32 `protobuf:"varint,1,opt,name=NewView" json:"NewView,omitempty"`
+type DeleteListRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	ListId    int64  `protobuf:"varint,2,opt,name=list_id,json=listId" json:"list_id,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
 }
 
-func (m *PromptViewChangeArgs) Reset()                    { *m = PromptViewChangeArgs{} }
-func (m *PromptViewChangeArgs) String() string            { return proto.CompactTextString(m) }
-func (*PromptViewChangeArgs) ProtoMessage()               {}
-func (*PromptViewChangeArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (m *DeleteListRequest) Reset()                    { *m = DeleteListRequest{} }
+func (m *DeleteListRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteListRequest) ProtoMessage()               {}
+func (*DeleteListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
-func (m *PromptViewChangeArgs) GetNewView() int32 {
+func (m *DeleteListRequest) GetUsername() string {
 	if m != nil {
-		return m.NewView
+		return m.Username
+	}
+	return ""
+}
+
+func (m *DeleteListRequest) GetListId() int64 {
+	if m != nil {
+		return m.ListId
 	}
 	return 0
 }
 
-type PromptViewChangeReply struct {
-	Success bool `protobuf:"varint,1,opt,name=Success" json:"Success,omitempty"`
+func (m *DeleteListRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
 }
 
-func (m *PromptViewChangeReply) Reset()                    { *m = PromptViewChangeReply{} }
-func (m *PromptViewChangeReply) String() string            { return proto.CompactTextString(m) }
-func (*PromptViewChangeReply) ProtoMessage()               {}
-func (*PromptViewChangeReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+type DeleteListReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
 
-func (m *PromptViewChangeReply) GetSuccess() bool {
+func (m *DeleteListReply) Reset()                    { *m = DeleteListReply{} }
+func (m *DeleteListReply) String() string            { return proto.CompactTextString(m) }
+func (*DeleteListReply) ProtoMessage()               {}
+func (*DeleteListReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *DeleteListReply) GetStatus() bool {
 	if m != nil {
-		return m.Success
+		return m.Status
 	}
 	return false
 }
 
-func init() {
-	proto.RegisterType((*HelloRequest)(nil), "helloworld.HelloRequest")
-	proto.RegisterType((*HelloReply)(nil), "helloworld.HelloReply")
-	proto.RegisterType((*Credentials)(nil), "helloworld.Credentials")
-	proto.RegisterType((*RegisterReply)(nil), "helloworld.RegisterReply")
-	proto.RegisterType((*LoginReply)(nil), "helloworld.LoginReply")
-	proto.RegisterType((*UserExistsReply)(nil), "helloworld.UserExistsReply")
-	proto.RegisterType((*UserExistsRequest)(nil), "helloworld.UserExistsRequest")
-	proto.RegisterType((*AddTweetRequest)(nil), "helloworld.AddTweetRequest")
-	proto.RegisterType((*AddTweetReply)(nil), "helloworld.AddTweetReply")
-	proto.RegisterType((*Tweet)(nil), "helloworld.Tweet")
-	proto.RegisterType((*OwnTweetsReply)(nil), "helloworld.OwnTweetsReply")
-	proto.RegisterType((*OwnTweetsRequest)(nil), "helloworld.OwnTweetsRequest")
-	proto.RegisterType((*DeleteReply)(nil), "helloworld.DeleteReply")
-	proto.RegisterType((*User)(nil), "helloworld.User")
-	proto.RegisterType((*UsersToFollowRequest)(nil), "helloworld.UsersToFollowRequest")
-	proto.RegisterType((*UsersToFollowResponse)(nil), "helloworld.UsersToFollowResponse")
-	proto.RegisterType((*FollowUserRequest)(nil), "helloworld.FollowUserRequest")
-	proto.RegisterType((*FollowUserResponse)(nil), "helloworld.FollowUserResponse")
-	proto.RegisterType((*GetFriendsTweetsRequest)(nil), "helloworld.GetFriendsTweetsRequest")
-	proto.RegisterType((*UsersAllTweets)(nil), "helloworld.UsersAllTweets")
-	proto.RegisterType((*GetFriendsTweetsResponse)(nil), "helloworld.GetFriendsTweetsResponse")
-	proto.RegisterType((*PrepareArgs)(nil), "helloworld.PrepareArgs")
-	proto.RegisterType((*PrepareReply)(nil), "helloworld.PrepareReply")
-	proto.RegisterType((*RecoveryArgs)(nil), "helloworld.RecoveryArgs")
-	proto.RegisterType((*RecoveryReply)(nil), "helloworld.RecoveryReply")
-	proto.RegisterType((*UserData)(nil), "helloworld.UserData")
-	proto.RegisterType((*ViewChangeArgs)(nil), "helloworld.ViewChangeArgs")
-	proto.RegisterType((*ViewChangeReply)(nil), "helloworld.ViewChangeReply")
-	proto.RegisterType((*StartViewArgs)(nil), "helloworld.StartViewArgs")
-	proto.RegisterType((*StartViewReply)(nil), "helloworld.StartViewReply")
-	proto.RegisterType((*WhoisPrimaryRequest)(nil), "helloworld.WhoisPrimaryRequest")
-	proto.RegisterType((*WhoIsPrimaryResponse)(nil), "helloworld.WhoIsPrimaryResponse")
-	proto.RegisterType((*HeartBeatRequest)(nil), "helloworld.HeartBeatRequest")
-	proto.RegisterType((*HeartBeatResponse)(nil), "helloworld.HeartBeatResponse")
-	proto.RegisterType((*PromptViewChangeArgs)(nil), "helloworld.PromptViewChangeArgs")
-	proto.RegisterType((*PromptViewChangeReply)(nil), "helloworld.PromptViewChangeReply")
+type ListMemberRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	ListId    int64  `protobuf:"varint,2,opt,name=list_id,json=listId" json:"list_id,omitempty"`
+	Member    string `protobuf:"bytes,3,opt,name=member" json:"member,omitempty"`
+	Broadcast bool   `protobuf:"varint,4,opt,name=broadcast" json:"broadcast,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ListMemberRequest) Reset()                    { *m = ListMemberRequest{} }
+func (m *ListMemberRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListMemberRequest) ProtoMessage()               {}
+func (*ListMemberRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
-// This is a compile-time assertion to ensure that this generated file
+func (m *ListMemberRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ListMemberRequest) GetListId() int64 {
+	if m != nil {
+		return m.ListId
+	}
+	return 0
+}
+
+func (m *ListMemberRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *ListMemberRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type ListMemberReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *ListMemberReply) Reset()                    { *m = ListMemberReply{} }
+func (m *ListMemberReply) String() string            { return proto.CompactTextString(m) }
+func (*ListMemberReply) ProtoMessage()               {}
+func (*ListMemberReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *ListMemberReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type GetListsRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Viewer   string `protobuf:"bytes,2,opt,name=viewer" json:"viewer,omitempty"`
+}
+
+func (m *GetListsRequest) Reset()                    { *m = GetListsRequest{} }
+func (m *GetListsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetListsRequest) ProtoMessage()               {}
+func (*GetListsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *GetListsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetListsRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
+type GetListsReply struct {
+	Lists []*UserList `protobuf:"bytes,1,rep,name=lists" json:"lists,omitempty"`
+}
+
+func (m *GetListsReply) Reset()                    { *m = GetListsReply{} }
+func (m *GetListsReply) String() string            { return proto.CompactTextString(m) }
+func (*GetListsReply) ProtoMessage()               {}
+func (*GetListsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *GetListsReply) GetLists() []*UserList {
+	if m != nil {
+		return m.Lists
+	}
+	return nil
+}
+
+type GetListRequest struct {
+	ListId int64  `protobuf:"varint,1,opt,name=list_id,json=listId" json:"list_id,omitempty"`
+	Viewer string `protobuf:"bytes,2,opt,name=viewer" json:"viewer,omitempty"`
+}
+
+func (m *GetListRequest) Reset()                    { *m = GetListRequest{} }
+func (m *GetListRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetListRequest) ProtoMessage()               {}
+func (*GetListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *GetListRequest) GetListId() int64 {
+	if m != nil {
+		return m.ListId
+	}
+	return 0
+}
+
+func (m *GetListRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
+type UserList struct {
+	Id          int64    `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Owner       string   `protobuf:"bytes,2,opt,name=owner" json:"owner,omitempty"`
+	Name        string   `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Description string   `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	Private     bool     `protobuf:"varint,5,opt,name=private" json:"private,omitempty"`
+	CreatedAt   int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	MemberCount int32    `protobuf:"varint,7,opt,name=member_count,json=memberCount" json:"member_count,omitempty"`
+	Members     []string `protobuf:"bytes,8,rep,name=members" json:"members,omitempty"`
+}
+
+func (m *UserList) Reset()                    { *m = UserList{} }
+func (m *UserList) String() string            { return proto.CompactTextString(m) }
+func (*UserList) ProtoMessage()               {}
+func (*UserList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *UserList) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UserList) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *UserList) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UserList) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UserList) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *UserList) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *UserList) GetMemberCount() int32 {
+	if m != nil {
+		return m.MemberCount
+	}
+	return 0
+}
+
+func (m *UserList) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type ListTimelineRequest struct {
+	ListId int64  `protobuf:"varint,1,opt,name=list_id,json=listId" json:"list_id,omitempty"`
+	Viewer string `protobuf:"bytes,2,opt,name=viewer" json:"viewer,omitempty"`
+	Limit  int32  `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,4,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *ListTimelineRequest) Reset()                    { *m = ListTimelineRequest{} }
+func (m *ListTimelineRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTimelineRequest) ProtoMessage()               {}
+func (*ListTimelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *ListTimelineRequest) GetListId() int64 {
+	if m != nil {
+		return m.ListId
+	}
+	return 0
+}
+
+func (m *ListTimelineRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
+func (m *ListTimelineRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListTimelineRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type Media struct {
+	Id        string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Thumbnail string `protobuf:"bytes,2,opt,name=thumbnail" json:"thumbnail,omitempty"`
+}
+
+func (m *Media) Reset()                    { *m = Media{} }
+func (m *Media) String() string            { return proto.CompactTextString(m) }
+func (*Media) ProtoMessage()               {}
+func (*Media) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *Media) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Media) GetThumbnail() string {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return ""
+}
+
+type RetweetRequest struct {
+	Username   string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetId    int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+	TweetText  string `protobuf:"bytes,3,opt,name=tweet_text,json=tweetText" json:"tweet_text,omitempty"`
+	Broadcast  bool   `protobuf:"varint,4,opt,name=broadcast" json:"broadcast,omitempty"`
+	NewTweetId int64  `protobuf:"varint,5,opt,name=new_tweet_id,json=newTweetId" json:"new_tweet_id,omitempty"`
+	Timestamp  int64  `protobuf:"varint,6,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *RetweetRequest) Reset()                    { *m = RetweetRequest{} }
+func (m *RetweetRequest) String() string            { return proto.CompactTextString(m) }
+func (*RetweetRequest) ProtoMessage()               {}
+func (*RetweetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *RetweetRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RetweetRequest) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+func (m *RetweetRequest) GetTweetText() string {
+	if m != nil {
+		return m.TweetText
+	}
+	return ""
+}
+
+func (m *RetweetRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *RetweetRequest) GetNewTweetId() int64 {
+	if m != nil {
+		return m.NewTweetId
+	}
+	return 0
+}
+
+func (m *RetweetRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type RetweetReply struct {
+	Status  bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	TweetId int64 `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+}
+
+func (m *RetweetReply) Reset()                    { *m = RetweetReply{} }
+func (m *RetweetReply) String() string            { return proto.CompactTextString(m) }
+func (*RetweetReply) ProtoMessage()               {}
+func (*RetweetReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *RetweetReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func (m *RetweetReply) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+type ConversationRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetId  int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+}
+
+func (m *ConversationRequest) Reset()                    { *m = ConversationRequest{} }
+func (m *ConversationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConversationRequest) ProtoMessage()               {}
+func (*ConversationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *ConversationRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ConversationRequest) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+type ConversationNode struct {
+	Tweet   *Tweet              `protobuf:"bytes,1,opt,name=tweet" json:"tweet,omitempty"`
+	Replies []*ConversationNode `protobuf:"bytes,2,rep,name=replies" json:"replies,omitempty"`
+}
+
+func (m *ConversationNode) Reset()                    { *m = ConversationNode{} }
+func (m *ConversationNode) String() string            { return proto.CompactTextString(m) }
+func (*ConversationNode) ProtoMessage()               {}
+func (*ConversationNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *ConversationNode) GetTweet() *Tweet {
+	if m != nil {
+		return m.Tweet
+	}
+	return nil
+}
+
+func (m *ConversationNode) GetReplies() []*ConversationNode {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
+type ConversationReply struct {
+	Root *ConversationNode `protobuf:"bytes,1,opt,name=root" json:"root,omitempty"`
+}
+
+func (m *ConversationReply) Reset()                    { *m = ConversationReply{} }
+func (m *ConversationReply) String() string            { return proto.CompactTextString(m) }
+func (*ConversationReply) ProtoMessage()               {}
+func (*ConversationReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *ConversationReply) GetRoot() *ConversationNode {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type TweetEdit struct {
+	Text      string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *TweetEdit) Reset()                    { *m = TweetEdit{} }
+func (m *TweetEdit) String() string            { return proto.CompactTextString(m) }
+func (*TweetEdit) ProtoMessage()               {}
+func (*TweetEdit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *TweetEdit) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *TweetEdit) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type DeleteTweetRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetId   int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *DeleteTweetRequest) Reset()                    { *m = DeleteTweetRequest{} }
+func (m *DeleteTweetRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTweetRequest) ProtoMessage()               {}
+func (*DeleteTweetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *DeleteTweetRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *DeleteTweetRequest) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+func (m *DeleteTweetRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type DeleteTweetReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *DeleteTweetReply) Reset()                    { *m = DeleteTweetReply{} }
+func (m *DeleteTweetReply) String() string            { return proto.CompactTextString(m) }
+func (*DeleteTweetReply) ProtoMessage()               {}
+func (*DeleteTweetReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *DeleteTweetReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type EditTweetRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetId   int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+	TweetText string `protobuf:"bytes,3,opt,name=tweet_text,json=tweetText" json:"tweet_text,omitempty"`
+	Broadcast bool   `protobuf:"varint,4,opt,name=broadcast" json:"broadcast,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *EditTweetRequest) Reset()                    { *m = EditTweetRequest{} }
+func (m *EditTweetRequest) String() string            { return proto.CompactTextString(m) }
+func (*EditTweetRequest) ProtoMessage()               {}
+func (*EditTweetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *EditTweetRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *EditTweetRequest) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+func (m *EditTweetRequest) GetTweetText() string {
+	if m != nil {
+		return m.TweetText
+	}
+	return ""
+}
+
+func (m *EditTweetRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *EditTweetRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type EditTweetReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *EditTweetReply) Reset()                    { *m = EditTweetReply{} }
+func (m *EditTweetReply) String() string            { return proto.CompactTextString(m) }
+func (*EditTweetReply) ProtoMessage()               {}
+func (*EditTweetReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *EditTweetReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type LikeRequest struct {
+	Username       string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	TweetId        int64  `protobuf:"varint,2,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+	Broadcast      bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+	Timestamp      int64  `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	NotificationId int64  `protobuf:"varint,5,opt,name=notification_id,json=notificationId" json:"notification_id,omitempty"`
+}
+
+func (m *LikeRequest) Reset()                    { *m = LikeRequest{} }
+func (m *LikeRequest) String() string            { return proto.CompactTextString(m) }
+func (*LikeRequest) ProtoMessage()               {}
+func (*LikeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *LikeRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *LikeRequest) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+func (m *LikeRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *LikeRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *LikeRequest) GetNotificationId() int64 {
+	if m != nil {
+		return m.NotificationId
+	}
+	return 0
+}
+
+type LikeReply struct {
+	Status bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	Likes  int32 `protobuf:"varint,2,opt,name=likes" json:"likes,omitempty"`
+}
+
+func (m *LikeReply) Reset()                    { *m = LikeReply{} }
+func (m *LikeReply) String() string            { return proto.CompactTextString(m) }
+func (*LikeReply) ProtoMessage()               {}
+func (*LikeReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *LikeReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func (m *LikeReply) GetLikes() int32 {
+	if m != nil {
+		return m.Likes
+	}
+	return 0
+}
+
+type Notification struct {
+	Id        int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Kind      string `protobuf:"bytes,2,opt,name=kind" json:"kind,omitempty"`
+	Actor     string `protobuf:"bytes,3,opt,name=actor" json:"actor,omitempty"`
+	TweetId   int64  `protobuf:"varint,4,opt,name=tweet_id,json=tweetId" json:"tweet_id,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	Read      bool   `protobuf:"varint,6,opt,name=read" json:"read,omitempty"`
+	Tweet     *Tweet `protobuf:"bytes,7,opt,name=tweet" json:"tweet,omitempty"`
+}
+
+func (m *Notification) Reset()                    { *m = Notification{} }
+func (m *Notification) String() string            { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()               {}
+func (*Notification) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *Notification) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Notification) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Notification) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *Notification) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+func (m *Notification) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Notification) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *Notification) GetTweet() *Tweet {
+	if m != nil {
+		return m.Tweet
+	}
+	return nil
+}
+
+type NotificationsRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Limit    int32  `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *NotificationsRequest) Reset()                    { *m = NotificationsRequest{} }
+func (m *NotificationsRequest) String() string            { return proto.CompactTextString(m) }
+func (*NotificationsRequest) ProtoMessage()               {}
+func (*NotificationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *NotificationsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *NotificationsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *NotificationsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type NotificationsReply struct {
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications" json:"notifications,omitempty"`
+	NextCursor    string          `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+	Unread        int32           `protobuf:"varint,3,opt,name=unread" json:"unread,omitempty"`
+}
+
+func (m *NotificationsReply) Reset()                    { *m = NotificationsReply{} }
+func (m *NotificationsReply) String() string            { return proto.CompactTextString(m) }
+func (*NotificationsReply) ProtoMessage()               {}
+func (*NotificationsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *NotificationsReply) GetNotifications() []*Notification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+func (m *NotificationsReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *NotificationsReply) GetUnread() int32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+type MarkNotificationsReadRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	UpTo      int64  `protobuf:"varint,2,opt,name=up_to,json=upTo" json:"up_to,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *MarkNotificationsReadRequest) Reset()                    { *m = MarkNotificationsReadRequest{} }
+func (m *MarkNotificationsReadRequest) String() string            { return proto.CompactTextString(m) }
+func (*MarkNotificationsReadRequest) ProtoMessage()               {}
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *MarkNotificationsReadRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *MarkNotificationsReadRequest) GetUpTo() int64 {
+	if m != nil {
+		return m.UpTo
+	}
+	return 0
+}
+
+func (m *MarkNotificationsReadRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type MarkNotificationsReadReply struct {
+	Status bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	Unread int32 `protobuf:"varint,2,opt,name=unread" json:"unread,omitempty"`
+}
+
+func (m *MarkNotificationsReadReply) Reset()                    { *m = MarkNotificationsReadReply{} }
+func (m *MarkNotificationsReadReply) String() string            { return proto.CompactTextString(m) }
+func (*MarkNotificationsReadReply) ProtoMessage()               {}
+func (*MarkNotificationsReadReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *MarkNotificationsReadReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func (m *MarkNotificationsReadReply) GetUnread() int32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+type OwnTweetsReply struct {
+	TweetList []*Tweet `protobuf:"bytes,1,rep,name=tweetList" json:"tweetList,omitempty"`
+}
+
+func (m *OwnTweetsReply) Reset()                    { *m = OwnTweetsReply{} }
+func (m *OwnTweetsReply) String() string            { return proto.CompactTextString(m) }
+func (*OwnTweetsReply) ProtoMessage()               {}
+func (*OwnTweetsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *OwnTweetsReply) GetTweetList() []*Tweet {
+	if m != nil {
+		return m.TweetList
+	}
+	return nil
+}
+
+type OwnTweetsRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Viewer   string `protobuf:"bytes,2,opt,name=viewer" json:"viewer,omitempty"`
+}
+
+func (m *OwnTweetsRequest) Reset()                    { *m = OwnTweetsRequest{} }
+func (m *OwnTweetsRequest) String() string            { return proto.CompactTextString(m) }
+func (*OwnTweetsRequest) ProtoMessage()               {}
+func (*OwnTweetsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *OwnTweetsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OwnTweetsRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
+type DeleteReply struct {
+	DeleteStatus bool `protobuf:"varint,1,opt,name=deleteStatus" json:"deleteStatus,omitempty"`
+}
+
+func (m *DeleteReply) Reset()                    { *m = DeleteReply{} }
+func (m *DeleteReply) String() string            { return proto.CompactTextString(m) }
+func (*DeleteReply) ProtoMessage()               {}
+func (*DeleteReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *DeleteReply) GetDeleteStatus() bool {
+	if m != nil {
+		return m.DeleteStatus
+	}
+	return false
+}
+
+type RestoreReply struct {
+	RestoreStatus bool `protobuf:"varint,1,opt,name=restoreStatus" json:"restoreStatus,omitempty"`
+}
+
+func (m *RestoreReply) Reset()                    { *m = RestoreReply{} }
+func (m *RestoreReply) String() string            { return proto.CompactTextString(m) }
+func (*RestoreReply) ProtoMessage()               {}
+func (*RestoreReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *RestoreReply) GetRestoreStatus() bool {
+	if m != nil {
+		return m.RestoreStatus
+	}
+	return false
+}
+
+type User struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Protected bool   `protobuf:"varint,2,opt,name=protected" json:"protected,omitempty"`
+}
+
+func (m *User) Reset()                    { *m = User{} }
+func (m *User) String() string            { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()               {}
+func (*User) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *User) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *User) GetProtected() bool {
+	if m != nil {
+		return m.Protected
+	}
+	return false
+}
+
+type UsersToFollowRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+}
+
+func (m *UsersToFollowRequest) Reset()                    { *m = UsersToFollowRequest{} }
+func (m *UsersToFollowRequest) String() string            { return proto.CompactTextString(m) }
+func (*UsersToFollowRequest) ProtoMessage()               {}
+func (*UsersToFollowRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *UsersToFollowRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type UsersToFollowResponse struct {
+	UsersToFollowList []*User `protobuf:"bytes,1,rep,name=usersToFollowList" json:"usersToFollowList,omitempty"`
+}
+
+func (m *UsersToFollowResponse) Reset()                    { *m = UsersToFollowResponse{} }
+func (m *UsersToFollowResponse) String() string            { return proto.CompactTextString(m) }
+func (*UsersToFollowResponse) ProtoMessage()               {}
+func (*UsersToFollowResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *UsersToFollowResponse) GetUsersToFollowList() []*User {
+	if m != nil {
+		return m.UsersToFollowList
+	}
+	return nil
+}
+
+type FollowUserRequest struct {
+	SelfUsername     string `protobuf:"bytes,1,opt,name=selfUsername" json:"selfUsername,omitempty"`
+	ToFollowUsername string `protobuf:"bytes,2,opt,name=toFollowUsername" json:"toFollowUsername,omitempty"`
+	Broadcast        bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+	Timestamp        int64  `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	NotificationId   int64  `protobuf:"varint,5,opt,name=notification_id,json=notificationId" json:"notification_id,omitempty"`
+}
+
+func (m *FollowUserRequest) Reset()                    { *m = FollowUserRequest{} }
+func (m *FollowUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*FollowUserRequest) ProtoMessage()               {}
+func (*FollowUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *FollowUserRequest) GetSelfUsername() string {
+	if m != nil {
+		return m.SelfUsername
+	}
+	return ""
+}
+
+func (m *FollowUserRequest) GetToFollowUsername() string {
+	if m != nil {
+		return m.ToFollowUsername
+	}
+	return ""
+}
+
+func (m *FollowUserRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *FollowUserRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *FollowUserRequest) GetNotificationId() int64 {
+	if m != nil {
+		return m.NotificationId
+	}
+	return 0
+}
+
+type FollowUserResponse struct {
+	FollowStatus bool `protobuf:"varint,1,opt,name=followStatus" json:"followStatus,omitempty"`
+	Pending      bool `protobuf:"varint,2,opt,name=pending" json:"pending,omitempty"`
+}
+
+func (m *FollowUserResponse) Reset()                    { *m = FollowUserResponse{} }
+func (m *FollowUserResponse) String() string            { return proto.CompactTextString(m) }
+func (*FollowUserResponse) ProtoMessage()               {}
+func (*FollowUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *FollowUserResponse) GetFollowStatus() bool {
+	if m != nil {
+		return m.FollowStatus
+	}
+	return false
+}
+
+func (m *FollowUserResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+type UnfollowUserRequest struct {
+	SelfUsername       string `protobuf:"bytes,1,opt,name=selfUsername" json:"selfUsername,omitempty"`
+	ToUnfollowUsername string `protobuf:"bytes,2,opt,name=toUnfollowUsername" json:"toUnfollowUsername,omitempty"`
+	Broadcast          bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *UnfollowUserRequest) Reset()                    { *m = UnfollowUserRequest{} }
+func (m *UnfollowUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnfollowUserRequest) ProtoMessage()               {}
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *UnfollowUserRequest) GetSelfUsername() string {
+	if m != nil {
+		return m.SelfUsername
+	}
+	return ""
+}
+
+func (m *UnfollowUserRequest) GetToUnfollowUsername() string {
+	if m != nil {
+		return m.ToUnfollowUsername
+	}
+	return ""
+}
+
+func (m *UnfollowUserRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type UnfollowUserResponse struct {
+	UnfollowStatus bool `protobuf:"varint,1,opt,name=unfollowStatus" json:"unfollowStatus,omitempty"`
+}
+
+func (m *UnfollowUserResponse) Reset()                    { *m = UnfollowUserResponse{} }
+func (m *UnfollowUserResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnfollowUserResponse) ProtoMessage()               {}
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *UnfollowUserResponse) GetUnfollowStatus() bool {
+	if m != nil {
+		return m.UnfollowStatus
+	}
+	return false
+}
+
+type UpdateProfileRequest struct {
+	Username     string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	DisplayName  string `protobuf:"bytes,2,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	Bio          string `protobuf:"bytes,3,opt,name=bio" json:"bio,omitempty"`
+	Location     string `protobuf:"bytes,4,opt,name=location" json:"location,omitempty"`
+	Website      string `protobuf:"bytes,5,opt,name=website" json:"website,omitempty"`
+	Avatar       string `protobuf:"bytes,6,opt,name=avatar" json:"avatar,omitempty"`
+	RemoveAvatar bool   `protobuf:"varint,7,opt,name=remove_avatar,json=removeAvatar" json:"remove_avatar,omitempty"`
+	Broadcast    bool   `protobuf:"varint,8,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *UpdateProfileRequest) Reset()                    { *m = UpdateProfileRequest{} }
+func (m *UpdateProfileRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateProfileRequest) ProtoMessage()               {}
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *UpdateProfileRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *UpdateProfileRequest) GetRemoveAvatar() bool {
+	if m != nil {
+		return m.RemoveAvatar
+	}
+	return false
+}
+
+func (m *UpdateProfileRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type UpdateProfileReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *UpdateProfileReply) Reset()                    { *m = UpdateProfileReply{} }
+func (m *UpdateProfileReply) String() string            { return proto.CompactTextString(m) }
+func (*UpdateProfileReply) ProtoMessage()               {}
+func (*UpdateProfileReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *UpdateProfileReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type ProfileRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Viewer   string `protobuf:"bytes,2,opt,name=viewer" json:"viewer,omitempty"`
+}
+
+func (m *ProfileRequest) Reset()                    { *m = ProfileRequest{} }
+func (m *ProfileRequest) String() string            { return proto.CompactTextString(m) }
+func (*ProfileRequest) ProtoMessage()               {}
+func (*ProfileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *ProfileRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ProfileRequest) GetViewer() string {
+	if m != nil {
+		return m.Viewer
+	}
+	return ""
+}
+
+type Profile struct {
+	Username      string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	DisplayName   string `protobuf:"bytes,2,opt,name=display_name,json=displayName" json:"display_name,omitempty"`
+	Bio           string `protobuf:"bytes,3,opt,name=bio" json:"bio,omitempty"`
+	Location      string `protobuf:"bytes,4,opt,name=location" json:"location,omitempty"`
+	Website       string `protobuf:"bytes,5,opt,name=website" json:"website,omitempty"`
+	Avatar        string `protobuf:"bytes,6,opt,name=avatar" json:"avatar,omitempty"`
+	Protected     bool   `protobuf:"varint,7,opt,name=protected" json:"protected,omitempty"`
+	Followers     int32  `protobuf:"varint,8,opt,name=followers" json:"followers,omitempty"`
+	Following     int32  `protobuf:"varint,9,opt,name=following" json:"following,omitempty"`
+	Tweets        int32  `protobuf:"varint,10,opt,name=tweets" json:"tweets,omitempty"`
+	Followed      bool   `protobuf:"varint,11,opt,name=followed" json:"followed,omitempty"`
+	Requested     bool   `protobuf:"varint,12,opt,name=requested" json:"requested,omitempty"`
+	FollowsViewer bool   `protobuf:"varint,13,opt,name=follows_viewer,json=followsViewer" json:"follows_viewer,omitempty"`
+	Blocked       bool   `protobuf:"varint,14,opt,name=blocked" json:"blocked,omitempty"`
+}
+
+func (m *Profile) Reset()                    { *m = Profile{} }
+func (m *Profile) String() string            { return proto.CompactTextString(m) }
+func (*Profile) ProtoMessage()               {}
+func (*Profile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *Profile) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Profile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Profile) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *Profile) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *Profile) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *Profile) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *Profile) GetProtected() bool {
+	if m != nil {
+		return m.Protected
+	}
+	return false
+}
+
+func (m *Profile) GetFollowers() int32 {
+	if m != nil {
+		return m.Followers
+	}
+	return 0
+}
+
+func (m *Profile) GetFollowing() int32 {
+	if m != nil {
+		return m.Following
+	}
+	return 0
+}
+
+func (m *Profile) GetTweets() int32 {
+	if m != nil {
+		return m.Tweets
+	}
+	return 0
+}
+
+func (m *Profile) GetFollowed() bool {
+	if m != nil {
+		return m.Followed
+	}
+	return false
+}
+
+func (m *Profile) GetRequested() bool {
+	if m != nil {
+		return m.Requested
+	}
+	return false
+}
+
+func (m *Profile) GetFollowsViewer() bool {
+	if m != nil {
+		return m.FollowsViewer
+	}
+	return false
+}
+
+func (m *Profile) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+type BlobRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Local bool   `protobuf:"varint,2,opt,name=local" json:"local,omitempty"`
+}
+
+func (m *BlobRequest) Reset()                    { *m = BlobRequest{} }
+func (m *BlobRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlobRequest) ProtoMessage()               {}
+func (*BlobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *BlobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BlobRequest) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+type BlobReply struct {
+	Data        []byte `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType" json:"content_type,omitempty"`
+}
+
+func (m *BlobReply) Reset()                    { *m = BlobReply{} }
+func (m *BlobReply) String() string            { return proto.CompactTextString(m) }
+func (*BlobReply) ProtoMessage()               {}
+func (*BlobReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *BlobReply) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *BlobReply) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+type UploadAvatarRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Data      []byte `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *UploadAvatarRequest) Reset()                    { *m = UploadAvatarRequest{} }
+func (m *UploadAvatarRequest) String() string            { return proto.CompactTextString(m) }
+func (*UploadAvatarRequest) ProtoMessage()               {}
+func (*UploadAvatarRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *UploadAvatarRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UploadAvatarRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UploadAvatarRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type UploadAvatarReply struct {
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *UploadAvatarReply) Reset()                    { *m = UploadAvatarReply{} }
+func (m *UploadAvatarReply) String() string            { return proto.CompactTextString(m) }
+func (*UploadAvatarReply) ProtoMessage()               {}
+func (*UploadAvatarReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+func (m *UploadAvatarReply) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UploadMediaRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Data      []byte `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *UploadMediaRequest) Reset()                    { *m = UploadMediaRequest{} }
+func (m *UploadMediaRequest) String() string            { return proto.CompactTextString(m) }
+func (*UploadMediaRequest) ProtoMessage()               {}
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+func (m *UploadMediaRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UploadMediaRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *UploadMediaRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type UploadMediaReply struct {
+	Media *Media `protobuf:"bytes,1,opt,name=media" json:"media,omitempty"`
+}
+
+func (m *UploadMediaReply) Reset()                    { *m = UploadMediaReply{} }
+func (m *UploadMediaReply) String() string            { return proto.CompactTextString(m) }
+func (*UploadMediaReply) ProtoMessage()               {}
+func (*UploadMediaReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *UploadMediaReply) GetMedia() *Media {
+	if m != nil {
+		return m.Media
+	}
+	return nil
+}
+
+type FollowRequestDecision struct {
+	Username       string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Requester      string `protobuf:"bytes,2,opt,name=requester" json:"requester,omitempty"`
+	Broadcast      bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+	Timestamp      int64  `protobuf:"varint,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	NotificationId int64  `protobuf:"varint,5,opt,name=notification_id,json=notificationId" json:"notification_id,omitempty"`
+}
+
+func (m *FollowRequestDecision) Reset()                    { *m = FollowRequestDecision{} }
+func (m *FollowRequestDecision) String() string            { return proto.CompactTextString(m) }
+func (*FollowRequestDecision) ProtoMessage()               {}
+func (*FollowRequestDecision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *FollowRequestDecision) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *FollowRequestDecision) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *FollowRequestDecision) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *FollowRequestDecision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *FollowRequestDecision) GetNotificationId() int64 {
+	if m != nil {
+		return m.NotificationId
+	}
+	return 0
+}
+
+type FollowRequestReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *FollowRequestReply) Reset()                    { *m = FollowRequestReply{} }
+func (m *FollowRequestReply) String() string            { return proto.CompactTextString(m) }
+func (*FollowRequestReply) ProtoMessage()               {}
+func (*FollowRequestReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *FollowRequestReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type ProtectRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Protected bool   `protobuf:"varint,2,opt,name=protected" json:"protected,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *ProtectRequest) Reset()                    { *m = ProtectRequest{} }
+func (m *ProtectRequest) String() string            { return proto.CompactTextString(m) }
+func (*ProtectRequest) ProtoMessage()               {}
+func (*ProtectRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *ProtectRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ProtectRequest) GetProtected() bool {
+	if m != nil {
+		return m.Protected
+	}
+	return false
+}
+
+func (m *ProtectRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type ProtectReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *ProtectReply) Reset()                    { *m = ProtectReply{} }
+func (m *ProtectReply) String() string            { return proto.CompactTextString(m) }
+func (*ProtectReply) ProtoMessage()               {}
+func (*ProtectReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *ProtectReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type BlockRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Target    string `protobuf:"bytes,2,opt,name=target" json:"target,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *BlockRequest) Reset()                    { *m = BlockRequest{} }
+func (m *BlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()               {}
+func (*BlockRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *BlockRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *BlockRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *BlockRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type BlockReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *BlockReply) Reset()                    { *m = BlockReply{} }
+func (m *BlockReply) String() string            { return proto.CompactTextString(m) }
+func (*BlockReply) ProtoMessage()               {}
+func (*BlockReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *BlockReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type ListFollowsRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Limit    int32  `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *ListFollowsRequest) Reset()                    { *m = ListFollowsRequest{} }
+func (m *ListFollowsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListFollowsRequest) ProtoMessage()               {}
+func (*ListFollowsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *ListFollowsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ListFollowsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListFollowsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ListFollowsResponse struct {
+	Users      []*User `protobuf:"bytes,1,rep,name=users" json:"users,omitempty"`
+	NextCursor string  `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+	Count      int32   `protobuf:"varint,3,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *ListFollowsResponse) Reset()                    { *m = ListFollowsResponse{} }
+func (m *ListFollowsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListFollowsResponse) ProtoMessage()               {}
+func (*ListFollowsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *ListFollowsResponse) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *ListFollowsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *ListFollowsResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetFriendsTweetsRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+}
+
+func (m *GetFriendsTweetsRequest) Reset()                    { *m = GetFriendsTweetsRequest{} }
+func (m *GetFriendsTweetsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetFriendsTweetsRequest) ProtoMessage()               {}
+func (*GetFriendsTweetsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+func (m *GetFriendsTweetsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type UsersAllTweets struct {
+	Username *User    `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Tweets   []*Tweet `protobuf:"bytes,2,rep,name=tweets" json:"tweets,omitempty"`
+}
+
+func (m *UsersAllTweets) Reset()                    { *m = UsersAllTweets{} }
+func (m *UsersAllTweets) String() string            { return proto.CompactTextString(m) }
+func (*UsersAllTweets) ProtoMessage()               {}
+func (*UsersAllTweets) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+func (m *UsersAllTweets) GetUsername() *User {
+	if m != nil {
+		return m.Username
+	}
+	return nil
+}
+
+func (m *UsersAllTweets) GetTweets() []*Tweet {
+	if m != nil {
+		return m.Tweets
+	}
+	return nil
+}
+
+type GetFriendsTweetsResponse struct {
+	FriendsTweets []*UsersAllTweets `protobuf:"bytes,1,rep,name=friendsTweets" json:"friendsTweets,omitempty"`
+}
+
+func (m *GetFriendsTweetsResponse) Reset()                    { *m = GetFriendsTweetsResponse{} }
+func (m *GetFriendsTweetsResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetFriendsTweetsResponse) ProtoMessage()               {}
+func (*GetFriendsTweetsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *GetFriendsTweetsResponse) GetFriendsTweets() []*UsersAllTweets {
+	if m != nil {
+		return m.FriendsTweets
+	}
+	return nil
+}
+
+type HomeTimelineRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Limit    int32  `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *HomeTimelineRequest) Reset()                    { *m = HomeTimelineRequest{} }
+func (m *HomeTimelineRequest) String() string            { return proto.CompactTextString(m) }
+func (*HomeTimelineRequest) ProtoMessage()               {}
+func (*HomeTimelineRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *HomeTimelineRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *HomeTimelineRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *HomeTimelineRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type HomeTimelineResponse struct {
+	Tweets     []*Tweet `protobuf:"bytes,1,rep,name=tweets" json:"tweets,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+}
+
+func (m *HomeTimelineResponse) Reset()                    { *m = HomeTimelineResponse{} }
+func (m *HomeTimelineResponse) String() string            { return proto.CompactTextString(m) }
+func (*HomeTimelineResponse) ProtoMessage()               {}
+func (*HomeTimelineResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+
+func (m *HomeTimelineResponse) GetTweets() []*Tweet {
+	if m != nil {
+		return m.Tweets
+	}
+	return nil
+}
+
+func (m *HomeTimelineResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type HashtagRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Hashtag  string `protobuf:"bytes,2,opt,name=hashtag" json:"hashtag,omitempty"`
+	Limit    int32  `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,4,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *HashtagRequest) Reset()                    { *m = HashtagRequest{} }
+func (m *HashtagRequest) String() string            { return proto.CompactTextString(m) }
+func (*HashtagRequest) ProtoMessage()               {}
+func (*HashtagRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *HashtagRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *HashtagRequest) GetHashtag() string {
+	if m != nil {
+		return m.Hashtag
+	}
+	return ""
+}
+
+func (m *HashtagRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *HashtagRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type TrendsRequest struct {
+	Limit         int32 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	WindowMinutes int32 `protobuf:"varint,2,opt,name=window_minutes,json=windowMinutes" json:"window_minutes,omitempty"`
+}
+
+func (m *TrendsRequest) Reset()                    { *m = TrendsRequest{} }
+func (m *TrendsRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrendsRequest) ProtoMessage()               {}
+func (*TrendsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *TrendsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *TrendsRequest) GetWindowMinutes() int32 {
+	if m != nil {
+		return m.WindowMinutes
+	}
+	return 0
+}
+
+type Trend struct {
+	Hashtag string `protobuf:"bytes,1,opt,name=hashtag" json:"hashtag,omitempty"`
+	Tweets  int32  `protobuf:"varint,2,opt,name=tweets" json:"tweets,omitempty"`
+	Authors int32  `protobuf:"varint,3,opt,name=authors" json:"authors,omitempty"`
+}
+
+func (m *Trend) Reset()                    { *m = Trend{} }
+func (m *Trend) String() string            { return proto.CompactTextString(m) }
+func (*Trend) ProtoMessage()               {}
+func (*Trend) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *Trend) GetHashtag() string {
+	if m != nil {
+		return m.Hashtag
+	}
+	return ""
+}
+
+func (m *Trend) GetTweets() int32 {
+	if m != nil {
+		return m.Tweets
+	}
+	return 0
+}
+
+func (m *Trend) GetAuthors() int32 {
+	if m != nil {
+		return m.Authors
+	}
+	return 0
+}
+
+type TrendsReply struct {
+	Trends []*Trend `protobuf:"bytes,1,rep,name=trends" json:"trends,omitempty"`
+}
+
+func (m *TrendsReply) Reset()                    { *m = TrendsReply{} }
+func (m *TrendsReply) String() string            { return proto.CompactTextString(m) }
+func (*TrendsReply) ProtoMessage()               {}
+func (*TrendsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+func (m *TrendsReply) GetTrends() []*Trend {
+	if m != nil {
+		return m.Trends
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Query    string `protobuf:"bytes,2,opt,name=query" json:"query,omitempty"`
+	Author   string `protobuf:"bytes,3,opt,name=author" json:"author,omitempty"`
+	Since    int64  `protobuf:"varint,4,opt,name=since" json:"since,omitempty"`
+	Until    int64  `protobuf:"varint,5,opt,name=until" json:"until,omitempty"`
+	Limit    int32  `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,7,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (m *SearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+func (m *SearchRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type SearchReply struct {
+	Tweets     []*Tweet `protobuf:"bytes,1,rep,name=tweets" json:"tweets,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+	Total      int32    `protobuf:"varint,3,opt,name=total" json:"total,omitempty"`
+}
+
+func (m *SearchReply) Reset()                    { *m = SearchReply{} }
+func (m *SearchReply) String() string            { return proto.CompactTextString(m) }
+func (*SearchReply) ProtoMessage()               {}
+func (*SearchReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *SearchReply) GetTweets() []*Tweet {
+	if m != nil {
+		return m.Tweets
+	}
+	return nil
+}
+
+func (m *SearchReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *SearchReply) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type DirectMessage struct {
+	Id             int64  `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ConversationId int64  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId" json:"conversation_id,omitempty"`
+	Sender         string `protobuf:"bytes,3,opt,name=sender" json:"sender,omitempty"`
+	Text           string `protobuf:"bytes,4,opt,name=text" json:"text,omitempty"`
+	Timestamp      int64  `protobuf:"varint,5,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *DirectMessage) Reset()                    { *m = DirectMessage{} }
+func (m *DirectMessage) String() string            { return proto.CompactTextString(m) }
+func (*DirectMessage) ProtoMessage()               {}
+func (*DirectMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+func (m *DirectMessage) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DirectMessage) GetConversationId() int64 {
+	if m != nil {
+		return m.ConversationId
+	}
+	return 0
+}
+
+func (m *DirectMessage) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *DirectMessage) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type DMConversation struct {
+	Id          int64          `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Members     []string       `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
+	LastMessage *DirectMessage `protobuf:"bytes,3,opt,name=last_message,json=lastMessage" json:"last_message,omitempty"`
+}
+
+func (m *DMConversation) Reset()                    { *m = DMConversation{} }
+func (m *DMConversation) String() string            { return proto.CompactTextString(m) }
+func (*DMConversation) ProtoMessage()               {}
+func (*DMConversation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+func (m *DMConversation) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DMConversation) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *DMConversation) GetLastMessage() *DirectMessage {
+	if m != nil {
+		return m.LastMessage
+	}
+	return nil
+}
+
+type SendMessageRequest struct {
+	Username       string   `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Recipients     []string `protobuf:"bytes,2,rep,name=recipients" json:"recipients,omitempty"`
+	ConversationId int64    `protobuf:"varint,3,opt,name=conversation_id,json=conversationId" json:"conversation_id,omitempty"`
+	Text           string   `protobuf:"bytes,4,opt,name=text" json:"text,omitempty"`
+	Broadcast      bool     `protobuf:"varint,5,opt,name=broadcast" json:"broadcast,omitempty"`
+	MessageId      int64    `protobuf:"varint,6,opt,name=message_id,json=messageId" json:"message_id,omitempty"`
+	Timestamp      int64    `protobuf:"varint,7,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *SendMessageRequest) Reset()                    { *m = SendMessageRequest{} }
+func (m *SendMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendMessageRequest) ProtoMessage()               {}
+func (*SendMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *SendMessageRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SendMessageRequest) GetRecipients() []string {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *SendMessageRequest) GetConversationId() int64 {
+	if m != nil {
+		return m.ConversationId
+	}
+	return 0
+}
+
+func (m *SendMessageRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SendMessageRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *SendMessageRequest) GetMessageId() int64 {
+	if m != nil {
+		return m.MessageId
+	}
+	return 0
+}
+
+func (m *SendMessageRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type SendMessageReply struct {
+	Status         bool  `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+	ConversationId int64 `protobuf:"varint,2,opt,name=conversation_id,json=conversationId" json:"conversation_id,omitempty"`
+	MessageId      int64 `protobuf:"varint,3,opt,name=message_id,json=messageId" json:"message_id,omitempty"`
+}
+
+func (m *SendMessageReply) Reset()                    { *m = SendMessageReply{} }
+func (m *SendMessageReply) String() string            { return proto.CompactTextString(m) }
+func (*SendMessageReply) ProtoMessage()               {}
+func (*SendMessageReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *SendMessageReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func (m *SendMessageReply) GetConversationId() int64 {
+	if m != nil {
+		return m.ConversationId
+	}
+	return 0
+}
+
+func (m *SendMessageReply) GetMessageId() int64 {
+	if m != nil {
+		return m.MessageId
+	}
+	return 0
+}
+
+type ListConversationsRequest struct {
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	Limit    int32  `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,3,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *ListConversationsRequest) Reset()                    { *m = ListConversationsRequest{} }
+func (m *ListConversationsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListConversationsRequest) ProtoMessage()               {}
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *ListConversationsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ListConversationsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListConversationsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ListConversationsReply struct {
+	Conversations []*DMConversation `protobuf:"bytes,1,rep,name=conversations" json:"conversations,omitempty"`
+	NextCursor    string            `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+}
+
+func (m *ListConversationsReply) Reset()                    { *m = ListConversationsReply{} }
+func (m *ListConversationsReply) String() string            { return proto.CompactTextString(m) }
+func (*ListConversationsReply) ProtoMessage()               {}
+func (*ListConversationsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *ListConversationsReply) GetConversations() []*DMConversation {
+	if m != nil {
+		return m.Conversations
+	}
+	return nil
+}
+
+func (m *ListConversationsReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ConversationMessagesRequest struct {
+	Username       string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	ConversationId int64  `protobuf:"varint,2,opt,name=conversation_id,json=conversationId" json:"conversation_id,omitempty"`
+	Limit          int32  `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	Cursor         string `protobuf:"bytes,4,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *ConversationMessagesRequest) Reset()                    { *m = ConversationMessagesRequest{} }
+func (m *ConversationMessagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConversationMessagesRequest) ProtoMessage()               {}
+func (*ConversationMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *ConversationMessagesRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ConversationMessagesRequest) GetConversationId() int64 {
+	if m != nil {
+		return m.ConversationId
+	}
+	return 0
+}
+
+func (m *ConversationMessagesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ConversationMessagesRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type ConversationMessagesReply struct {
+	Messages   []*DirectMessage `protobuf:"bytes,1,rep,name=messages" json:"messages,omitempty"`
+	NextCursor string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
+	Members    []string         `protobuf:"bytes,3,rep,name=members" json:"members,omitempty"`
+}
+
+func (m *ConversationMessagesReply) Reset()                    { *m = ConversationMessagesReply{} }
+func (m *ConversationMessagesReply) String() string            { return proto.CompactTextString(m) }
+func (*ConversationMessagesReply) ProtoMessage()               {}
+func (*ConversationMessagesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *ConversationMessagesReply) GetMessages() []*DirectMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *ConversationMessagesReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *ConversationMessagesReply) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type DMSettingsRequest struct {
+	Username  string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	OpenDms   bool   `protobuf:"varint,2,opt,name=open_dms,json=openDms" json:"open_dms,omitempty"`
+	Broadcast bool   `protobuf:"varint,3,opt,name=broadcast" json:"broadcast,omitempty"`
+}
+
+func (m *DMSettingsRequest) Reset()                    { *m = DMSettingsRequest{} }
+func (m *DMSettingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DMSettingsRequest) ProtoMessage()               {}
+func (*DMSettingsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+
+func (m *DMSettingsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *DMSettingsRequest) GetOpenDms() bool {
+	if m != nil {
+		return m.OpenDms
+	}
+	return false
+}
+
+func (m *DMSettingsRequest) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+type DMSettingsReply struct {
+	Status bool `protobuf:"varint,1,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *DMSettingsReply) Reset()                    { *m = DMSettingsReply{} }
+func (m *DMSettingsReply) String() string            { return proto.CompactTextString(m) }
+func (*DMSettingsReply) ProtoMessage()               {}
+func (*DMSettingsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+
+func (m *DMSettingsReply) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type PrepareArgs struct {
+	View          int32  `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
+	PrimaryCommit int32  `protobuf:"varint,2,opt,name=PrimaryCommit" json:"PrimaryCommit,omitempty"`
+	Index         int32  `protobuf:"varint,3,opt,name=Index" json:"Index,omitempty"`
+	Entry         string `protobuf:"bytes,4,opt,name=Entry" json:"Entry,omitempty"`
+	Hash          string `protobuf:"bytes,5,opt,name=Hash" json:"Hash,omitempty"`
+}
+
+func (m *PrepareArgs) Reset()                    { *m = PrepareArgs{} }
+func (m *PrepareArgs) String() string            { return proto.CompactTextString(m) }
+func (*PrepareArgs) ProtoMessage()               {}
+func (*PrepareArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+
+func (m *PrepareArgs) GetView() int32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+func (m *PrepareArgs) GetPrimaryCommit() int32 {
+	if m != nil {
+		return m.PrimaryCommit
+	}
+	return 0
+}
+
+func (m *PrepareArgs) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PrepareArgs) GetEntry() string {
+	if m != nil {
+		return m.Entry
+	}
+	return ""
+}
+
+func (m *PrepareArgs) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type PrepareReply struct {
+	View    int32 `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
+	Success bool  `protobuf:"varint,2,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *PrepareReply) Reset()                    { *m = PrepareReply{} }
+func (m *PrepareReply) String() string            { return proto.CompactTextString(m) }
+func (*PrepareReply) ProtoMessage()               {}
+func (*PrepareReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+
+func (m *PrepareReply) GetView() int32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+func (m *PrepareReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type RecoveryArgs struct {
+	View   int32 `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
+	Server int32 `protobuf:"varint,2,opt,name=Server" json:"Server,omitempty"`
+	Nonce  int64 `protobuf:"varint,3,opt,name=Nonce" json:"Nonce,omitempty"`
+}
+
+func (m *RecoveryArgs) Reset()                    { *m = RecoveryArgs{} }
+func (m *RecoveryArgs) String() string            { return proto.CompactTextString(m) }
+func (*RecoveryArgs) ProtoMessage()               {}
+func (*RecoveryArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+
+func (m *RecoveryArgs) GetView() int32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+func (m *RecoveryArgs) GetServer() int32 {
+	if m != nil {
+		return m.Server
+	}
+	return 0
+}
+
+func (m *RecoveryArgs) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type RecoveryReply struct {
+	View          int32       `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
+	Entries       []*LogEntry `protobuf:"bytes,2,rep,name=Entries" json:"Entries,omitempty"`
+	PrimaryCommit int32       `protobuf:"varint,3,opt,name=PrimaryCommit" json:"PrimaryCommit,omitempty"`
+	Success       bool        `protobuf:"varint,4,opt,name=Success" json:"Success,omitempty"`
+	Data          []*UserData `protobuf:"bytes,5,rep,name=Data" json:"Data,omitempty"`
+	Nonce         int64       `protobuf:"varint,6,opt,name=Nonce" json:"Nonce,omitempty"`
+}
+
+func (m *RecoveryReply) Reset()                    { *m = RecoveryReply{} }
+func (m *RecoveryReply) String() string            { return proto.CompactTextString(m) }
+func (*RecoveryReply) ProtoMessage()               {}
+func (*RecoveryReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+
+func (m *RecoveryReply) GetView() int32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+func (m *RecoveryReply) GetEntries() []*LogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *RecoveryReply) GetPrimaryCommit() int32 {
+	if m != nil {
+		return m.PrimaryCommit
+	}
+	return 0
+}
+
+func (m *RecoveryReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *RecoveryReply) GetData() []*UserData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *RecoveryReply) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type LogEntry struct {
+	Command string `protobuf:"bytes,1,opt,name=Command" json:"Command,omitempty"`
+	Hash    string `protobuf:"bytes,2,opt,name=Hash" json:"Hash,omitempty"`
+}
+
+func (m *LogEntry) Reset()                    { *m = LogEntry{} }
+func (m *LogEntry) String() string            { return proto.CompactTextString(m) }
+func (*LogEntry) ProtoMessage()               {}
+func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+
+func (m *LogEntry) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *LogEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type UserData struct {
+	Username          string            `protobuf:"bytes,1,opt,name=Username" json:"Username,omitempty"`
+	Password          string            `protobuf:"bytes,2,opt,name=Password" json:"Password,omitempty"`
+	TweetList         []*Tweet          `protobuf:"bytes,3,rep,name=TweetList" json:"TweetList,omitempty"`
+	Follows           []string          `protobuf:"bytes,4,rep,name=Follows" json:"Follows,omitempty"`
+	DeletedAt         int64             `protobuf:"varint,5,opt,name=DeletedAt" json:"DeletedAt,omitempty"`
+	Likes             []int64           `protobuf:"varint,6,rep,name=Likes,packed" json:"Likes,omitempty"`
+	Notifications     []*Notification   `protobuf:"bytes,7,rep,name=Notifications" json:"Notifications,omitempty"`
+	NotificationsRead int64             `protobuf:"varint,8,opt,name=NotificationsRead" json:"NotificationsRead,omitempty"`
+	OpenDMs           bool              `protobuf:"varint,9,opt,name=OpenDMs" json:"OpenDMs,omitempty"`
+	Conversations     []*DMConversation `protobuf:"bytes,10,rep,name=Conversations" json:"Conversations,omitempty"`
+	Messages          []*DirectMessage  `protobuf:"bytes,11,rep,name=Messages" json:"Messages,omitempty"`
+	Blocks            []string          `protobuf:"bytes,12,rep,name=Blocks" json:"Blocks,omitempty"`
+	Mutes             []string          `protobuf:"bytes,13,rep,name=Mutes" json:"Mutes,omitempty"`
+	Protected         bool              `protobuf:"varint,14,opt,name=Protected" json:"Protected,omitempty"`
+	FollowRequests    []string          `protobuf:"bytes,15,rep,name=FollowRequests" json:"FollowRequests,omitempty"`
+	DisplayName       string            `protobuf:"bytes,16,opt,name=DisplayName" json:"DisplayName,omitempty"`
+	Bio               string            `protobuf:"bytes,17,opt,name=Bio" json:"Bio,omitempty"`
+	Location          string            `protobuf:"bytes,18,opt,name=Location" json:"Location,omitempty"`
+	Website           string            `protobuf:"bytes,19,opt,name=Website" json:"Website,omitempty"`
+	Avatar            string            `protobuf:"bytes,20,opt,name=Avatar" json:"Avatar,omitempty"`
+	AvatarData        []byte            `protobuf:"bytes,21,opt,name=AvatarData" json:"AvatarData,omitempty"`
+	Blobs             []*Blob           `protobuf:"bytes,22,rep,name=Blobs" json:"Blobs,omitempty"`
+	Votes             []*PollVote       `protobuf:"bytes,23,rep,name=Votes" json:"Votes,omitempty"`
+	Scheduled         []*ScheduledTweet `protobuf:"bytes,24,rep,name=Scheduled" json:"Scheduled,omitempty"`
+	Lists             []*UserList       `protobuf:"bytes,25,rep,name=Lists" json:"Lists,omitempty"`
+}
+
+func (m *UserData) Reset()                    { *m = UserData{} }
+func (m *UserData) String() string            { return proto.CompactTextString(m) }
+func (*UserData) ProtoMessage()               {}
+func (*UserData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+
+func (m *UserData) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserData) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *UserData) GetTweetList() []*Tweet {
+	if m != nil {
+		return m.TweetList
+	}
+	return nil
+}
+
+func (m *UserData) GetFollows() []string {
+	if m != nil {
+		return m.Follows
+	}
+	return nil
+}
+
+func (m *UserData) GetDeletedAt() int64 {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return 0
+}
+
+func (m *UserData) GetLikes() []int64 {
+	if m != nil {
+		return m.Likes
+	}
+	return nil
+}
+
+func (m *UserData) GetNotifications() []*Notification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+func (m *UserData) GetNotificationsRead() int64 {
+	if m != nil {
+		return m.NotificationsRead
+	}
+	return 0
+}
+
+func (m *UserData) GetOpenDMs() bool {
+	if m != nil {
+		return m.OpenDMs
+	}
+	return false
+}
+
+func (m *UserData) GetConversations() []*DMConversation {
+	if m != nil {
+		return m.Conversations
+	}
+	return nil
+}
+
+func (m *UserData) GetMessages() []*DirectMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *UserData) GetBlocks() []string {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *UserData) GetMutes() []string {
+	if m != nil {
+		return m.Mutes
+	}
+	return nil
+}
+
+func (m *UserData) GetProtected() bool {
+	if m != nil {
+		return m.Protected
+	}
+	return false
+}
+
+func (m *UserData) GetFollowRequests() []string {
+	if m != nil {
+		return m.FollowRequests
+	}
+	return nil
+}
+
+func (m *UserData) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *UserData) GetBio() string {
+	if m != nil {
+		return m.Bio
+	}
+	return ""
+}
+
+func (m *UserData) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *UserData) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *UserData) GetAvatar() string {
+	if m != nil {
+		return m.Avatar
+	}
+	return ""
+}
+
+func (m *UserData) GetAvatarData() []byte {
+	if m != nil {
+		return m.AvatarData
+	}
+	return nil
+}
+
+func (m *UserData) GetBlobs() []*Blob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *UserData) GetVotes() []*PollVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *UserData) GetScheduled() []*ScheduledTweet {
+	if m != nil {
+		return m.Scheduled
+	}
+	return nil
+}
+
+func (m *UserData) GetLists() []*UserList {
+	if m != nil {
+		return m.Lists
+	}
+	return nil
+}
+
+type PollVote struct {
+	TweetId int64 `protobuf:"varint,1,opt,name=TweetId" json:"TweetId,omitempty"`
+	Option  int32 `protobuf:"varint,2,opt,name=Option" json:"Option,omitempty"`
+}
+
+func (m *PollVote) Reset()                    { *m = PollVote{} }
+func (m *PollVote) String() string            { return proto.CompactTextString(m) }
+func (*PollVote) ProtoMessage()               {}
+func (*PollVote) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+
+func (m *PollVote) GetTweetId() int64 {
+	if m != nil {
+		return m.TweetId
+	}
+	return 0
+}
+
+func (m *PollVote) GetOption() int32 {
+	if m != nil {
+		return m.Option
+	}
+	return 0
+}
+
+type Blob struct {
+	Id   string `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=Data" json:"Data,omitempty"`
+}
+
+func (m *Blob) Reset()                    { *m = Blob{} }
+func (m *Blob) String() string            { return proto.CompactTextString(m) }
+func (*Blob) ProtoMessage()               {}
+func (*Blob) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
+
+func (m *Blob) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Blob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ViewChangeArgs struct {
+	View int32 `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
+}
+
+func (m *ViewChangeArgs) Reset()                    { *m = ViewChangeArgs{} }
+func (m *ViewChangeArgs) String() string            { return proto.CompactTextString(m) }
+func (*ViewChangeArgs) ProtoMessage()               {}
+func (*ViewChangeArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
+
+func (m *ViewChangeArgs) GetView() int32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+type ViewChangeReply struct {
+	LastNormalView int32       `protobuf:"varint,1,opt,name=LastNormalView" json:"LastNormalView,omitempty"`
+	Log            []*LogEntry `protobuf:"bytes,2,rep,name=Log" json:"Log,omitempty"`
+	Success        bool        `protobuf:"varint,3,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *ViewChangeReply) Reset()                    { *m = ViewChangeReply{} }
+func (m *ViewChangeReply) String() string            { return proto.CompactTextString(m) }
+func (*ViewChangeReply) ProtoMessage()               {}
+func (*ViewChangeReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
+
+func (m *ViewChangeReply) GetLastNormalView() int32 {
+	if m != nil {
+		return m.LastNormalView
+	}
+	return 0
+}
+
+func (m *ViewChangeReply) GetLog() []*LogEntry {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func (m *ViewChangeReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type StartViewArgs struct {
+	View int32       `protobuf:"varint,1,opt,name=View" json:"View,omitempty"`
+	Log  []*LogEntry `protobuf:"bytes,2,rep,name=Log" json:"Log,omitempty"`
+}
+
+func (m *StartViewArgs) Reset()                    { *m = StartViewArgs{} }
+func (m *StartViewArgs) String() string            { return proto.CompactTextString(m) }
+func (*StartViewArgs) ProtoMessage()               {}
+func (*StartViewArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
+
+func (m *StartViewArgs) GetView() int32 {
+	if m != nil {
+		return m.View
+	}
+	return 0
+}
+
+func (m *StartViewArgs) GetLog() []*LogEntry {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+type StartViewReply struct {
+}
+
+func (m *StartViewReply) Reset()                    { *m = StartViewReply{} }
+func (m *StartViewReply) String() string            { return proto.CompactTextString(m) }
+func (*StartViewReply) ProtoMessage()               {}
+func (*StartViewReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
+
+type WhoisPrimaryRequest struct {
+}
+
+func (m *WhoisPrimaryRequest) Reset()                    { *m = WhoisPrimaryRequest{} }
+func (m *WhoisPrimaryRequest) String() string            { return proto.CompactTextString(m) }
+func (*WhoisPrimaryRequest) ProtoMessage()               {}
+func (*WhoisPrimaryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
+
+type WhoIsPrimaryResponse struct {
+	Index int32 `protobuf:"varint,1,opt,name=Index" json:"Index,omitempty"`
+}
+
+func (m *WhoIsPrimaryResponse) Reset()                    { *m = WhoIsPrimaryResponse{} }
+func (m *WhoIsPrimaryResponse) String() string            { return proto.CompactTextString(m) }
+func (*WhoIsPrimaryResponse) ProtoMessage()               {}
+func (*WhoIsPrimaryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
+
+func (m *WhoIsPrimaryResponse) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type HeartBeatRequest struct {
+}
+
+func (m *HeartBeatRequest) Reset()                    { *m = HeartBeatRequest{} }
+func (m *HeartBeatRequest) String() string            { return proto.CompactTextString(m) }
+func (*HeartBeatRequest) ProtoMessage()               {}
+func (*HeartBeatRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
+
+type HeartBeatResponse struct {
+	IsAlive     bool  `protobuf:"varint,1,opt,name=IsAlive" json:"IsAlive,omitempty"`
+	CurrentView int32 `protobuf:"varint,2,opt,name=currentView" json:"currentView,omitempty"`
+}
+
+func (m *HeartBeatResponse) Reset()                    { *m = HeartBeatResponse{} }
+func (m *HeartBeatResponse) String() string            { return proto.CompactTextString(m) }
+func (*HeartBeatResponse) ProtoMessage()               {}
+func (*HeartBeatResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
+
+func (m *HeartBeatResponse) GetIsAlive() bool {
+	if m != nil {
+		return m.IsAlive
+	}
+	return false
+}
+
+func (m *HeartBeatResponse) GetCurrentView() int32 {
+	if m != nil {
+		return m.CurrentView
+	}
+	return 0
+}
+
+type PromptViewChangeArgs struct {
+	NewView int32 `protobuf:"varint,1,opt,name=NewView" json:"NewView,omitempty"`
+}
+
+func (m *PromptViewChangeArgs) Reset()                    { *m = PromptViewChangeArgs{} }
+func (m *PromptViewChangeArgs) String() string            { return proto.CompactTextString(m) }
+func (*PromptViewChangeArgs) ProtoMessage()               {}
+func (*PromptViewChangeArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+func (m *PromptViewChangeArgs) GetNewView() int32 {
+	if m != nil {
+		return m.NewView
+	}
+	return 0
+}
+
+type PromptViewChangeReply struct {
+	Success bool `protobuf:"varint,1,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *PromptViewChangeReply) Reset()                    { *m = PromptViewChangeReply{} }
+func (m *PromptViewChangeReply) String() string            { return proto.CompactTextString(m) }
+func (*PromptViewChangeReply) ProtoMessage()               {}
+func (*PromptViewChangeReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
+
+func (m *PromptViewChangeReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type LogHashArgs struct {
+	Op int32 `protobuf:"varint,1,opt,name=Op" json:"Op,omitempty"`
+}
+
+func (m *LogHashArgs) Reset()                    { *m = LogHashArgs{} }
+func (m *LogHashArgs) String() string            { return proto.CompactTextString(m) }
+func (*LogHashArgs) ProtoMessage()               {}
+func (*LogHashArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
+
+func (m *LogHashArgs) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+type LogHashReply struct {
+	OpNo  int32  `protobuf:"varint,1,opt,name=OpNo" json:"OpNo,omitempty"`
+	Hash  string `protobuf:"bytes,2,opt,name=Hash" json:"Hash,omitempty"`
+	Found bool   `protobuf:"varint,3,opt,name=Found" json:"Found,omitempty"`
+}
+
+func (m *LogHashReply) Reset()                    { *m = LogHashReply{} }
+func (m *LogHashReply) String() string            { return proto.CompactTextString(m) }
+func (*LogHashReply) ProtoMessage()               {}
+func (*LogHashReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
+
+func (m *LogHashReply) GetOpNo() int32 {
+	if m != nil {
+		return m.OpNo
+	}
+	return 0
+}
+
+func (m *LogHashReply) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *LogHashReply) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+type CompareLogsArgs struct {
+	Op int32 `protobuf:"varint,1,opt,name=Op" json:"Op,omitempty"`
+}
+
+func (m *CompareLogsArgs) Reset()                    { *m = CompareLogsArgs{} }
+func (m *CompareLogsArgs) String() string            { return proto.CompactTextString(m) }
+func (*CompareLogsArgs) ProtoMessage()               {}
+func (*CompareLogsArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
+
+func (m *CompareLogsArgs) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+type CompareLogsReply struct {
+	Op       int32            `protobuf:"varint,1,opt,name=Op" json:"Op,omitempty"`
+	Hash     string           `protobuf:"bytes,2,opt,name=Hash" json:"Hash,omitempty"`
+	Replicas []*LogComparison `protobuf:"bytes,3,rep,name=Replicas" json:"Replicas,omitempty"`
+}
+
+func (m *CompareLogsReply) Reset()                    { *m = CompareLogsReply{} }
+func (m *CompareLogsReply) String() string            { return proto.CompactTextString(m) }
+func (*CompareLogsReply) ProtoMessage()               {}
+func (*CompareLogsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
+
+func (m *CompareLogsReply) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *CompareLogsReply) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *CompareLogsReply) GetReplicas() []*LogComparison {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+type LogComparison struct {
+	Server           int32  `protobuf:"varint,1,opt,name=Server" json:"Server,omitempty"`
+	Reachable        bool   `protobuf:"varint,2,opt,name=Reachable" json:"Reachable,omitempty"`
+	OpNo             int32  `protobuf:"varint,3,opt,name=OpNo" json:"OpNo,omitempty"`
+	Hash             string `protobuf:"bytes,4,opt,name=Hash" json:"Hash,omitempty"`
+	Diverged         bool   `protobuf:"varint,5,opt,name=Diverged" json:"Diverged,omitempty"`
+	FirstDivergentOp int32  `protobuf:"varint,6,opt,name=FirstDivergentOp" json:"FirstDivergentOp,omitempty"`
+}
+
+func (m *LogComparison) Reset()                    { *m = LogComparison{} }
+func (m *LogComparison) String() string            { return proto.CompactTextString(m) }
+func (*LogComparison) ProtoMessage()               {}
+func (*LogComparison) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
+
+func (m *LogComparison) GetServer() int32 {
+	if m != nil {
+		return m.Server
+	}
+	return 0
+}
+
+func (m *LogComparison) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
+	}
+	return false
+}
+
+func (m *LogComparison) GetOpNo() int32 {
+	if m != nil {
+		return m.OpNo
+	}
+	return 0
+}
+
+func (m *LogComparison) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *LogComparison) GetDiverged() bool {
+	if m != nil {
+		return m.Diverged
+	}
+	return false
+}
+
+func (m *LogComparison) GetFirstDivergentOp() int32 {
+	if m != nil {
+		return m.FirstDivergentOp
+	}
+	return 0
+}
+
+type StateDigestArgs struct {
+}
+
+func (m *StateDigestArgs) Reset()                    { *m = StateDigestArgs{} }
+func (m *StateDigestArgs) String() string            { return proto.CompactTextString(m) }
+func (*StateDigestArgs) ProtoMessage()               {}
+func (*StateDigestArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
+
+type StateDigestReply struct {
+	OpNo    int32         `protobuf:"varint,1,opt,name=OpNo" json:"OpNo,omitempty"`
+	Trees   []*MerkleTree `protobuf:"bytes,2,rep,name=Trees" json:"Trees,omitempty"`
+	Success bool          `protobuf:"varint,3,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *StateDigestReply) Reset()                    { *m = StateDigestReply{} }
+func (m *StateDigestReply) String() string            { return proto.CompactTextString(m) }
+func (*StateDigestReply) ProtoMessage()               {}
+func (*StateDigestReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
+
+func (m *StateDigestReply) GetOpNo() int32 {
+	if m != nil {
+		return m.OpNo
+	}
+	return 0
+}
+
+func (m *StateDigestReply) GetTrees() []*MerkleTree {
+	if m != nil {
+		return m.Trees
+	}
+	return nil
+}
+
+func (m *StateDigestReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type MerkleTree struct {
+	Kind  string   `protobuf:"bytes,1,opt,name=Kind" json:"Kind,omitempty"`
+	Nodes []string `protobuf:"bytes,2,rep,name=Nodes" json:"Nodes,omitempty"`
+}
+
+func (m *MerkleTree) Reset()                    { *m = MerkleTree{} }
+func (m *MerkleTree) String() string            { return proto.CompactTextString(m) }
+func (*MerkleTree) ProtoMessage()               {}
+func (*MerkleTree) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
+
+func (m *MerkleTree) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *MerkleTree) GetNodes() []string {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type StateRangeArgs struct {
+	Buckets []int32 `protobuf:"varint,1,rep,name=Buckets,packed" json:"Buckets,omitempty"`
+}
+
+func (m *StateRangeArgs) Reset()                    { *m = StateRangeArgs{} }
+func (m *StateRangeArgs) String() string            { return proto.CompactTextString(m) }
+func (*StateRangeArgs) ProtoMessage()               {}
+func (*StateRangeArgs) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
+
+func (m *StateRangeArgs) GetBuckets() []int32 {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type StateRangeReply struct {
+	Data    []*UserData `protobuf:"bytes,1,rep,name=Data" json:"Data,omitempty"`
+	Success bool        `protobuf:"varint,2,opt,name=Success" json:"Success,omitempty"`
+	OpNo    int32       `protobuf:"varint,3,opt,name=OpNo" json:"OpNo,omitempty"`
+}
+
+func (m *StateRangeReply) Reset()                    { *m = StateRangeReply{} }
+func (m *StateRangeReply) String() string            { return proto.CompactTextString(m) }
+func (*StateRangeReply) ProtoMessage()               {}
+func (*StateRangeReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
+
+func (m *StateRangeReply) GetData() []*UserData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *StateRangeReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *StateRangeReply) GetOpNo() int32 {
+	if m != nil {
+		return m.OpNo
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HelloRequest)(nil), "helloworld.HelloRequest")
+	proto.RegisterType((*HelloReply)(nil), "helloworld.HelloReply")
+	proto.RegisterType((*Credentials)(nil), "helloworld.Credentials")
+	proto.RegisterType((*RegisterReply)(nil), "helloworld.RegisterReply")
+	proto.RegisterType((*LoginReply)(nil), "helloworld.LoginReply")
+	proto.RegisterType((*UserExistsReply)(nil), "helloworld.UserExistsReply")
+	proto.RegisterType((*UserExistsRequest)(nil), "helloworld.UserExistsRequest")
+	proto.RegisterType((*AddTweetRequest)(nil), "helloworld.AddTweetRequest")
+	proto.RegisterType((*AddTweetReply)(nil), "helloworld.AddTweetReply")
+	proto.RegisterType((*Tweet)(nil), "helloworld.Tweet")
+	proto.RegisterType((*Poll)(nil), "helloworld.Poll")
+	proto.RegisterType((*PollOption)(nil), "helloworld.PollOption")
+	proto.RegisterType((*VotePollRequest)(nil), "helloworld.VotePollRequest")
+	proto.RegisterType((*VotePollReply)(nil), "helloworld.VotePollReply")
+	proto.RegisterType((*ScheduleTweetRequest)(nil), "helloworld.ScheduleTweetRequest")
+	proto.RegisterType((*ScheduleTweetReply)(nil), "helloworld.ScheduleTweetReply")
+	proto.RegisterType((*ScheduledTweet)(nil), "helloworld.ScheduledTweet")
+	proto.RegisterType((*ListScheduledRequest)(nil), "helloworld.ListScheduledRequest")
+	proto.RegisterType((*ListScheduledReply)(nil), "helloworld.ListScheduledReply")
+	proto.RegisterType((*CancelScheduledRequest)(nil), "helloworld.CancelScheduledRequest")
+	proto.RegisterType((*CancelScheduledReply)(nil), "helloworld.CancelScheduledReply")
+	proto.RegisterType((*CreateListRequest)(nil), "helloworld.CreateListRequest")
+	proto.RegisterType((*CreateListReply)(nil), "helloworld.CreateListReply")
+	proto.RegisterType((*DeleteListRequest)(nil), "helloworld.DeleteListRequest")
+	proto.RegisterType((*DeleteListReply)(nil), "helloworld.DeleteListReply")
+	proto.RegisterType((*ListMemberRequest)(nil), "helloworld.ListMemberRequest")
+	proto.RegisterType((*ListMemberReply)(nil), "helloworld.ListMemberReply")
+	proto.RegisterType((*GetListsRequest)(nil), "helloworld.GetListsRequest")
+	proto.RegisterType((*GetListsReply)(nil), "helloworld.GetListsReply")
+	proto.RegisterType((*GetListRequest)(nil), "helloworld.GetListRequest")
+	proto.RegisterType((*UserList)(nil), "helloworld.UserList")
+	proto.RegisterType((*ListTimelineRequest)(nil), "helloworld.ListTimelineRequest")
+	proto.RegisterType((*Media)(nil), "helloworld.Media")
+	proto.RegisterType((*RetweetRequest)(nil), "helloworld.RetweetRequest")
+	proto.RegisterType((*RetweetReply)(nil), "helloworld.RetweetReply")
+	proto.RegisterType((*ConversationRequest)(nil), "helloworld.ConversationRequest")
+	proto.RegisterType((*ConversationNode)(nil), "helloworld.ConversationNode")
+	proto.RegisterType((*ConversationReply)(nil), "helloworld.ConversationReply")
+	proto.RegisterType((*TweetEdit)(nil), "helloworld.TweetEdit")
+	proto.RegisterType((*DeleteTweetRequest)(nil), "helloworld.DeleteTweetRequest")
+	proto.RegisterType((*DeleteTweetReply)(nil), "helloworld.DeleteTweetReply")
+	proto.RegisterType((*EditTweetRequest)(nil), "helloworld.EditTweetRequest")
+	proto.RegisterType((*EditTweetReply)(nil), "helloworld.EditTweetReply")
+	proto.RegisterType((*LikeRequest)(nil), "helloworld.LikeRequest")
+	proto.RegisterType((*LikeReply)(nil), "helloworld.LikeReply")
+	proto.RegisterType((*Notification)(nil), "helloworld.Notification")
+	proto.RegisterType((*NotificationsRequest)(nil), "helloworld.NotificationsRequest")
+	proto.RegisterType((*NotificationsReply)(nil), "helloworld.NotificationsReply")
+	proto.RegisterType((*MarkNotificationsReadRequest)(nil), "helloworld.MarkNotificationsReadRequest")
+	proto.RegisterType((*MarkNotificationsReadReply)(nil), "helloworld.MarkNotificationsReadReply")
+	proto.RegisterType((*OwnTweetsReply)(nil), "helloworld.OwnTweetsReply")
+	proto.RegisterType((*OwnTweetsRequest)(nil), "helloworld.OwnTweetsRequest")
+	proto.RegisterType((*DeleteReply)(nil), "helloworld.DeleteReply")
+	proto.RegisterType((*RestoreReply)(nil), "helloworld.RestoreReply")
+	proto.RegisterType((*User)(nil), "helloworld.User")
+	proto.RegisterType((*UsersToFollowRequest)(nil), "helloworld.UsersToFollowRequest")
+	proto.RegisterType((*UsersToFollowResponse)(nil), "helloworld.UsersToFollowResponse")
+	proto.RegisterType((*FollowUserRequest)(nil), "helloworld.FollowUserRequest")
+	proto.RegisterType((*FollowUserResponse)(nil), "helloworld.FollowUserResponse")
+	proto.RegisterType((*UnfollowUserRequest)(nil), "helloworld.UnfollowUserRequest")
+	proto.RegisterType((*UnfollowUserResponse)(nil), "helloworld.UnfollowUserResponse")
+	proto.RegisterType((*UpdateProfileRequest)(nil), "helloworld.UpdateProfileRequest")
+	proto.RegisterType((*UpdateProfileReply)(nil), "helloworld.UpdateProfileReply")
+	proto.RegisterType((*ProfileRequest)(nil), "helloworld.ProfileRequest")
+	proto.RegisterType((*Profile)(nil), "helloworld.Profile")
+	proto.RegisterType((*BlobRequest)(nil), "helloworld.BlobRequest")
+	proto.RegisterType((*BlobReply)(nil), "helloworld.BlobReply")
+	proto.RegisterType((*UploadAvatarRequest)(nil), "helloworld.UploadAvatarRequest")
+	proto.RegisterType((*UploadAvatarReply)(nil), "helloworld.UploadAvatarReply")
+	proto.RegisterType((*UploadMediaRequest)(nil), "helloworld.UploadMediaRequest")
+	proto.RegisterType((*UploadMediaReply)(nil), "helloworld.UploadMediaReply")
+	proto.RegisterType((*FollowRequestDecision)(nil), "helloworld.FollowRequestDecision")
+	proto.RegisterType((*FollowRequestReply)(nil), "helloworld.FollowRequestReply")
+	proto.RegisterType((*ProtectRequest)(nil), "helloworld.ProtectRequest")
+	proto.RegisterType((*ProtectReply)(nil), "helloworld.ProtectReply")
+	proto.RegisterType((*BlockRequest)(nil), "helloworld.BlockRequest")
+	proto.RegisterType((*BlockReply)(nil), "helloworld.BlockReply")
+	proto.RegisterType((*ListFollowsRequest)(nil), "helloworld.ListFollowsRequest")
+	proto.RegisterType((*ListFollowsResponse)(nil), "helloworld.ListFollowsResponse")
+	proto.RegisterType((*GetFriendsTweetsRequest)(nil), "helloworld.GetFriendsTweetsRequest")
+	proto.RegisterType((*UsersAllTweets)(nil), "helloworld.UsersAllTweets")
+	proto.RegisterType((*GetFriendsTweetsResponse)(nil), "helloworld.GetFriendsTweetsResponse")
+	proto.RegisterType((*HomeTimelineRequest)(nil), "helloworld.HomeTimelineRequest")
+	proto.RegisterType((*HomeTimelineResponse)(nil), "helloworld.HomeTimelineResponse")
+	proto.RegisterType((*HashtagRequest)(nil), "helloworld.HashtagRequest")
+	proto.RegisterType((*TrendsRequest)(nil), "helloworld.TrendsRequest")
+	proto.RegisterType((*Trend)(nil), "helloworld.Trend")
+	proto.RegisterType((*TrendsReply)(nil), "helloworld.TrendsReply")
+	proto.RegisterType((*SearchRequest)(nil), "helloworld.SearchRequest")
+	proto.RegisterType((*SearchReply)(nil), "helloworld.SearchReply")
+	proto.RegisterType((*DirectMessage)(nil), "helloworld.DirectMessage")
+	proto.RegisterType((*DMConversation)(nil), "helloworld.DMConversation")
+	proto.RegisterType((*SendMessageRequest)(nil), "helloworld.SendMessageRequest")
+	proto.RegisterType((*SendMessageReply)(nil), "helloworld.SendMessageReply")
+	proto.RegisterType((*ListConversationsRequest)(nil), "helloworld.ListConversationsRequest")
+	proto.RegisterType((*ListConversationsReply)(nil), "helloworld.ListConversationsReply")
+	proto.RegisterType((*ConversationMessagesRequest)(nil), "helloworld.ConversationMessagesRequest")
+	proto.RegisterType((*ConversationMessagesReply)(nil), "helloworld.ConversationMessagesReply")
+	proto.RegisterType((*DMSettingsRequest)(nil), "helloworld.DMSettingsRequest")
+	proto.RegisterType((*DMSettingsReply)(nil), "helloworld.DMSettingsReply")
+	proto.RegisterType((*PrepareArgs)(nil), "helloworld.PrepareArgs")
+	proto.RegisterType((*PrepareReply)(nil), "helloworld.PrepareReply")
+	proto.RegisterType((*RecoveryArgs)(nil), "helloworld.RecoveryArgs")
+	proto.RegisterType((*RecoveryReply)(nil), "helloworld.RecoveryReply")
+	proto.RegisterType((*LogEntry)(nil), "helloworld.LogEntry")
+	proto.RegisterType((*UserData)(nil), "helloworld.UserData")
+	proto.RegisterType((*PollVote)(nil), "helloworld.PollVote")
+	proto.RegisterType((*Blob)(nil), "helloworld.Blob")
+	proto.RegisterType((*ViewChangeArgs)(nil), "helloworld.ViewChangeArgs")
+	proto.RegisterType((*ViewChangeReply)(nil), "helloworld.ViewChangeReply")
+	proto.RegisterType((*StartViewArgs)(nil), "helloworld.StartViewArgs")
+	proto.RegisterType((*StartViewReply)(nil), "helloworld.StartViewReply")
+	proto.RegisterType((*WhoisPrimaryRequest)(nil), "helloworld.WhoisPrimaryRequest")
+	proto.RegisterType((*WhoIsPrimaryResponse)(nil), "helloworld.WhoIsPrimaryResponse")
+	proto.RegisterType((*HeartBeatRequest)(nil), "helloworld.HeartBeatRequest")
+	proto.RegisterType((*HeartBeatResponse)(nil), "helloworld.HeartBeatResponse")
+	proto.RegisterType((*PromptViewChangeArgs)(nil), "helloworld.PromptViewChangeArgs")
+	proto.RegisterType((*PromptViewChangeReply)(nil), "helloworld.PromptViewChangeReply")
+	proto.RegisterType((*LogHashArgs)(nil), "helloworld.LogHashArgs")
+	proto.RegisterType((*LogHashReply)(nil), "helloworld.LogHashReply")
+	proto.RegisterType((*CompareLogsArgs)(nil), "helloworld.CompareLogsArgs")
+	proto.RegisterType((*CompareLogsReply)(nil), "helloworld.CompareLogsReply")
+	proto.RegisterType((*LogComparison)(nil), "helloworld.LogComparison")
+	proto.RegisterType((*StateDigestArgs)(nil), "helloworld.StateDigestArgs")
+	proto.RegisterType((*StateDigestReply)(nil), "helloworld.StateDigestReply")
+	proto.RegisterType((*MerkleTree)(nil), "helloworld.MerkleTree")
+	proto.RegisterType((*StateRangeArgs)(nil), "helloworld.StateRangeArgs")
+	proto.RegisterType((*StateRangeReply)(nil), "helloworld.StateRangeReply")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
-// Client API for Greeter service
+// Client API for Greeter service
+
+type GreeterClient interface {
+	// Sends a greeting
+	SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
+	SayHelloAgain(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
+	Register(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*RegisterReply, error)
+	Login(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*LoginReply, error)
+	UserExists(ctx context.Context, in *UserExistsRequest, opts ...grpc.CallOption) (*UserExistsReply, error)
+	AddTweet(ctx context.Context, in *AddTweetRequest, opts ...grpc.CallOption) (*AddTweetReply, error)
+	OwnTweets(ctx context.Context, in *OwnTweetsRequest, opts ...grpc.CallOption) (*OwnTweetsReply, error)
+	DeleteTweet(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetReply, error)
+	EditTweet(ctx context.Context, in *EditTweetRequest, opts ...grpc.CallOption) (*EditTweetReply, error)
+	Retweet(ctx context.Context, in *RetweetRequest, opts ...grpc.CallOption) (*RetweetReply, error)
+	GetConversation(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationReply, error)
+	LikeTweet(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*LikeReply, error)
+	UnlikeTweet(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*LikeReply, error)
+	ListLikedTweets(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error)
+	ListNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*NotificationsReply, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadReply, error)
+	DeleteUser(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*DeleteReply, error)
+	RestoreUser(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*RestoreReply, error)
+	UsersToFollow(ctx context.Context, in *UsersToFollowRequest, opts ...grpc.CallOption) (*UsersToFollowResponse, error)
+	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
+	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error)
+	ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileReply, error)
+	GetProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Profile, error)
+	GetBlob(ctx context.Context, in *BlobRequest, opts ...grpc.CallOption) (*BlobReply, error)
+	UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*UploadAvatarReply, error)
+	UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*UploadMediaReply, error)
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollReply, error)
+	ScheduleTweet(ctx context.Context, in *ScheduleTweetRequest, opts ...grpc.CallOption) (*ScheduleTweetReply, error)
+	ListScheduledTweets(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ListScheduledReply, error)
+	CancelScheduledTweet(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*CancelScheduledReply, error)
+	CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*CreateListReply, error)
+	DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*DeleteListReply, error)
+	AddListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberReply, error)
+	RemoveListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberReply, error)
+	GetLists(ctx context.Context, in *GetListsRequest, opts ...grpc.CallOption) (*GetListsReply, error)
+	GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*UserList, error)
+	GetListTimeline(ctx context.Context, in *ListTimelineRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error)
+	ApproveFollowRequest(ctx context.Context, in *FollowRequestDecision, opts ...grpc.CallOption) (*FollowRequestReply, error)
+	RejectFollowRequest(ctx context.Context, in *FollowRequestDecision, opts ...grpc.CallOption) (*FollowRequestReply, error)
+	ListFollowRequests(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	SetProtected(ctx context.Context, in *ProtectRequest, opts ...grpc.CallOption) (*ProtectReply, error)
+	BlockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error)
+	UnblockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error)
+	MuteUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error)
+	UnmuteUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error)
+	ListBlocked(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	ListMuted(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	GetFriendsTweets(ctx context.Context, in *GetFriendsTweetsRequest, opts ...grpc.CallOption) (*GetFriendsTweetsResponse, error)
+	HomeTimeline(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error)
+	TweetsByHashtag(ctx context.Context, in *HashtagRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error)
+	GetTrends(ctx context.Context, in *TrendsRequest, opts ...grpc.CallOption) (*TrendsReply, error)
+	SearchTweets(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageReply, error)
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsReply, error)
+	GetConversationMessages(ctx context.Context, in *ConversationMessagesRequest, opts ...grpc.CallOption) (*ConversationMessagesReply, error)
+	UpdateDMSettings(ctx context.Context, in *DMSettingsRequest, opts ...grpc.CallOption) (*DMSettingsReply, error)
+	WhoIsPrimary(ctx context.Context, in *WhoisPrimaryRequest, opts ...grpc.CallOption) (*WhoIsPrimaryResponse, error)
+	HeartBeat(ctx context.Context, in *HeartBeatRequest, opts ...grpc.CallOption) (*HeartBeatResponse, error)
+	Prepare(ctx context.Context, in *PrepareArgs, opts ...grpc.CallOption) (*PrepareReply, error)
+	Recovery(ctx context.Context, in *RecoveryArgs, opts ...grpc.CallOption) (*RecoveryReply, error)
+	ViewChange(ctx context.Context, in *ViewChangeArgs, opts ...grpc.CallOption) (*ViewChangeReply, error)
+	PromptViewChange(ctx context.Context, in *PromptViewChangeArgs, opts ...grpc.CallOption) (*PromptViewChangeReply, error)
+	StartView(ctx context.Context, in *StartViewArgs, opts ...grpc.CallOption) (*StartViewReply, error)
+	LogHash(ctx context.Context, in *LogHashArgs, opts ...grpc.CallOption) (*LogHashReply, error)
+	// Admin RPC comparing this server's log with the other replicas
+	CompareLogs(ctx context.Context, in *CompareLogsArgs, opts ...grpc.CallOption) (*CompareLogsReply, error)
+	StateDigest(ctx context.Context, in *StateDigestArgs, opts ...grpc.CallOption) (*StateDigestReply, error)
+	StateRange(ctx context.Context, in *StateRangeArgs, opts ...grpc.CallOption) (*StateRangeReply, error)
+}
+
+type greeterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGreeterClient(cc *grpc.ClientConn) GreeterClient {
+	return &greeterClient{cc}
+}
+
+func (c *greeterClient) SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
+	out := new(HelloReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/SayHello", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) SayHelloAgain(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
+	out := new(HelloReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/SayHelloAgain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) Register(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*RegisterReply, error) {
+	out := new(RegisterReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/Register", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) Login(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*LoginReply, error) {
+	out := new(LoginReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/Login", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UserExists(ctx context.Context, in *UserExistsRequest, opts ...grpc.CallOption) (*UserExistsReply, error) {
+	out := new(UserExistsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UserExists", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) AddTweet(ctx context.Context, in *AddTweetRequest, opts ...grpc.CallOption) (*AddTweetReply, error) {
+	out := new(AddTweetReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/AddTweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) OwnTweets(ctx context.Context, in *OwnTweetsRequest, opts ...grpc.CallOption) (*OwnTweetsReply, error) {
+	out := new(OwnTweetsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/OwnTweets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) DeleteTweet(ctx context.Context, in *DeleteTweetRequest, opts ...grpc.CallOption) (*DeleteTweetReply, error) {
+	out := new(DeleteTweetReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/DeleteTweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) EditTweet(ctx context.Context, in *EditTweetRequest, opts ...grpc.CallOption) (*EditTweetReply, error) {
+	out := new(EditTweetReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/EditTweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) Retweet(ctx context.Context, in *RetweetRequest, opts ...grpc.CallOption) (*RetweetReply, error) {
+	out := new(RetweetReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/Retweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetConversation(ctx context.Context, in *ConversationRequest, opts ...grpc.CallOption) (*ConversationReply, error) {
+	out := new(ConversationReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetConversation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) LikeTweet(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*LikeReply, error) {
+	out := new(LikeReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/LikeTweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UnlikeTweet(ctx context.Context, in *LikeRequest, opts ...grpc.CallOption) (*LikeReply, error) {
+	out := new(LikeReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UnlikeTweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListLikedTweets(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error) {
+	out := new(HomeTimelineResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListLikedTweets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*NotificationsReply, error) {
+	out := new(NotificationsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListNotifications", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadReply, error) {
+	out := new(MarkNotificationsReadReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/MarkNotificationsRead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) DeleteUser(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*DeleteReply, error) {
+	out := new(DeleteReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/DeleteUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) RestoreUser(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*RestoreReply, error) {
+	out := new(RestoreReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/RestoreUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UsersToFollow(ctx context.Context, in *UsersToFollowRequest, opts ...grpc.CallOption) (*UsersToFollowResponse, error) {
+	out := new(UsersToFollowResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UsersToFollow", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error) {
+	out := new(FollowUserResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/FollowUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserResponse, error) {
+	out := new(UnfollowUserResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UnfollowUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	out := new(ListFollowsResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListFollowing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	out := new(ListFollowsResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListFollowers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileReply, error) {
+	out := new(UpdateProfileReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UpdateProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Profile, error) {
+	out := new(Profile)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetProfile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetBlob(ctx context.Context, in *BlobRequest, opts ...grpc.CallOption) (*BlobReply, error) {
+	out := new(BlobReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetBlob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UploadAvatar(ctx context.Context, in *UploadAvatarRequest, opts ...grpc.CallOption) (*UploadAvatarReply, error) {
+	out := new(UploadAvatarReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UploadAvatar", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*UploadMediaReply, error) {
+	out := new(UploadMediaReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UploadMedia", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollReply, error) {
+	out := new(VotePollReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/VotePoll", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ScheduleTweet(ctx context.Context, in *ScheduleTweetRequest, opts ...grpc.CallOption) (*ScheduleTweetReply, error) {
+	out := new(ScheduleTweetReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ScheduleTweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListScheduledTweets(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ListScheduledReply, error) {
+	out := new(ListScheduledReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListScheduledTweets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) CancelScheduledTweet(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*CancelScheduledReply, error) {
+	out := new(CancelScheduledReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/CancelScheduledTweet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*CreateListReply, error) {
+	out := new(CreateListReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/CreateList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*DeleteListReply, error) {
+	out := new(DeleteListReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/DeleteList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) AddListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberReply, error) {
+	out := new(ListMemberReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/AddListMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) RemoveListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberReply, error) {
+	out := new(ListMemberReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/RemoveListMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetLists(ctx context.Context, in *GetListsRequest, opts ...grpc.CallOption) (*GetListsReply, error) {
+	out := new(GetListsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetLists", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*UserList, error) {
+	out := new(UserList)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetListTimeline(ctx context.Context, in *ListTimelineRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error) {
+	out := new(HomeTimelineResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetListTimeline", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ApproveFollowRequest(ctx context.Context, in *FollowRequestDecision, opts ...grpc.CallOption) (*FollowRequestReply, error) {
+	out := new(FollowRequestReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ApproveFollowRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) RejectFollowRequest(ctx context.Context, in *FollowRequestDecision, opts ...grpc.CallOption) (*FollowRequestReply, error) {
+	out := new(FollowRequestReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/RejectFollowRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListFollowRequests(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	out := new(ListFollowsResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListFollowRequests", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) SetProtected(ctx context.Context, in *ProtectRequest, opts ...grpc.CallOption) (*ProtectReply, error) {
+	out := new(ProtectReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/SetProtected", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) BlockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error) {
+	out := new(BlockReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/BlockUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UnblockUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error) {
+	out := new(BlockReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UnblockUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) MuteUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error) {
+	out := new(BlockReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/MuteUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UnmuteUser(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockReply, error) {
+	out := new(BlockReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UnmuteUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListBlocked(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	out := new(ListFollowsResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListBlocked", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListMuted(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	out := new(ListFollowsResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListMuted", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetFriendsTweets(ctx context.Context, in *GetFriendsTweetsRequest, opts ...grpc.CallOption) (*GetFriendsTweetsResponse, error) {
+	out := new(GetFriendsTweetsResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetFriendsTweets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) HomeTimeline(ctx context.Context, in *HomeTimelineRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error) {
+	out := new(HomeTimelineResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/HomeTimeline", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) TweetsByHashtag(ctx context.Context, in *HashtagRequest, opts ...grpc.CallOption) (*HomeTimelineResponse, error) {
+	out := new(HomeTimelineResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/TweetsByHashtag", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetTrends(ctx context.Context, in *TrendsRequest, opts ...grpc.CallOption) (*TrendsReply, error) {
+	out := new(TrendsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetTrends", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) SearchTweets(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error) {
+	out := new(SearchReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/SearchTweets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageReply, error) {
+	out := new(SendMessageReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/SendMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsReply, error) {
+	out := new(ListConversationsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ListConversations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetConversationMessages(ctx context.Context, in *ConversationMessagesRequest, opts ...grpc.CallOption) (*ConversationMessagesReply, error) {
+	out := new(ConversationMessagesReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetConversationMessages", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) UpdateDMSettings(ctx context.Context, in *DMSettingsRequest, opts ...grpc.CallOption) (*DMSettingsReply, error) {
+	out := new(DMSettingsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/UpdateDMSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) WhoIsPrimary(ctx context.Context, in *WhoisPrimaryRequest, opts ...grpc.CallOption) (*WhoIsPrimaryResponse, error) {
+	out := new(WhoIsPrimaryResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/WhoIsPrimary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) HeartBeat(ctx context.Context, in *HeartBeatRequest, opts ...grpc.CallOption) (*HeartBeatResponse, error) {
+	out := new(HeartBeatResponse)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/HeartBeat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) Prepare(ctx context.Context, in *PrepareArgs, opts ...grpc.CallOption) (*PrepareReply, error) {
+	out := new(PrepareReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/Prepare", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) Recovery(ctx context.Context, in *RecoveryArgs, opts ...grpc.CallOption) (*RecoveryReply, error) {
+	out := new(RecoveryReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/Recovery", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ViewChange(ctx context.Context, in *ViewChangeArgs, opts ...grpc.CallOption) (*ViewChangeReply, error) {
+	out := new(ViewChangeReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/ViewChange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) PromptViewChange(ctx context.Context, in *PromptViewChangeArgs, opts ...grpc.CallOption) (*PromptViewChangeReply, error) {
+	out := new(PromptViewChangeReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/PromptViewChange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) StartView(ctx context.Context, in *StartViewArgs, opts ...grpc.CallOption) (*StartViewReply, error) {
+	out := new(StartViewReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/StartView", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) LogHash(ctx context.Context, in *LogHashArgs, opts ...grpc.CallOption) (*LogHashReply, error) {
+	out := new(LogHashReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/LogHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) CompareLogs(ctx context.Context, in *CompareLogsArgs, opts ...grpc.CallOption) (*CompareLogsReply, error) {
+	out := new(CompareLogsReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/CompareLogs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) StateDigest(ctx context.Context, in *StateDigestArgs, opts ...grpc.CallOption) (*StateDigestReply, error) {
+	out := new(StateDigestReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/StateDigest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) StateRange(ctx context.Context, in *StateRangeArgs, opts ...grpc.CallOption) (*StateRangeReply, error) {
+	out := new(StateRangeReply)
+	err := grpc.Invoke(ctx, "/helloworld.Greeter/StateRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Greeter service
+
+type GreeterServer interface {
+	// Sends a greeting
+	SayHello(context.Context, *HelloRequest) (*HelloReply, error)
+	SayHelloAgain(context.Context, *HelloRequest) (*HelloReply, error)
+	Register(context.Context, *Credentials) (*RegisterReply, error)
+	Login(context.Context, *Credentials) (*LoginReply, error)
+	UserExists(context.Context, *UserExistsRequest) (*UserExistsReply, error)
+	AddTweet(context.Context, *AddTweetRequest) (*AddTweetReply, error)
+	OwnTweets(context.Context, *OwnTweetsRequest) (*OwnTweetsReply, error)
+	DeleteTweet(context.Context, *DeleteTweetRequest) (*DeleteTweetReply, error)
+	EditTweet(context.Context, *EditTweetRequest) (*EditTweetReply, error)
+	Retweet(context.Context, *RetweetRequest) (*RetweetReply, error)
+	GetConversation(context.Context, *ConversationRequest) (*ConversationReply, error)
+	LikeTweet(context.Context, *LikeRequest) (*LikeReply, error)
+	UnlikeTweet(context.Context, *LikeRequest) (*LikeReply, error)
+	ListLikedTweets(context.Context, *HomeTimelineRequest) (*HomeTimelineResponse, error)
+	ListNotifications(context.Context, *NotificationsRequest) (*NotificationsReply, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadReply, error)
+	DeleteUser(context.Context, *Credentials) (*DeleteReply, error)
+	RestoreUser(context.Context, *Credentials) (*RestoreReply, error)
+	UsersToFollow(context.Context, *UsersToFollowRequest) (*UsersToFollowResponse, error)
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserResponse, error)
+	ListFollowing(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	ListFollowers(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileReply, error)
+	GetProfile(context.Context, *ProfileRequest) (*Profile, error)
+	GetBlob(context.Context, *BlobRequest) (*BlobReply, error)
+	UploadAvatar(context.Context, *UploadAvatarRequest) (*UploadAvatarReply, error)
+	UploadMedia(context.Context, *UploadMediaRequest) (*UploadMediaReply, error)
+	VotePoll(context.Context, *VotePollRequest) (*VotePollReply, error)
+	ScheduleTweet(context.Context, *ScheduleTweetRequest) (*ScheduleTweetReply, error)
+	ListScheduledTweets(context.Context, *ListScheduledRequest) (*ListScheduledReply, error)
+	CancelScheduledTweet(context.Context, *CancelScheduledRequest) (*CancelScheduledReply, error)
+	CreateList(context.Context, *CreateListRequest) (*CreateListReply, error)
+	DeleteList(context.Context, *DeleteListRequest) (*DeleteListReply, error)
+	AddListMember(context.Context, *ListMemberRequest) (*ListMemberReply, error)
+	RemoveListMember(context.Context, *ListMemberRequest) (*ListMemberReply, error)
+	GetLists(context.Context, *GetListsRequest) (*GetListsReply, error)
+	GetList(context.Context, *GetListRequest) (*UserList, error)
+	GetListTimeline(context.Context, *ListTimelineRequest) (*HomeTimelineResponse, error)
+	ApproveFollowRequest(context.Context, *FollowRequestDecision) (*FollowRequestReply, error)
+	RejectFollowRequest(context.Context, *FollowRequestDecision) (*FollowRequestReply, error)
+	ListFollowRequests(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	SetProtected(context.Context, *ProtectRequest) (*ProtectReply, error)
+	BlockUser(context.Context, *BlockRequest) (*BlockReply, error)
+	UnblockUser(context.Context, *BlockRequest) (*BlockReply, error)
+	MuteUser(context.Context, *BlockRequest) (*BlockReply, error)
+	UnmuteUser(context.Context, *BlockRequest) (*BlockReply, error)
+	ListBlocked(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	ListMuted(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	GetFriendsTweets(context.Context, *GetFriendsTweetsRequest) (*GetFriendsTweetsResponse, error)
+	HomeTimeline(context.Context, *HomeTimelineRequest) (*HomeTimelineResponse, error)
+	TweetsByHashtag(context.Context, *HashtagRequest) (*HomeTimelineResponse, error)
+	GetTrends(context.Context, *TrendsRequest) (*TrendsReply, error)
+	SearchTweets(context.Context, *SearchRequest) (*SearchReply, error)
+	SendMessage(context.Context, *SendMessageRequest) (*SendMessageReply, error)
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsReply, error)
+	GetConversationMessages(context.Context, *ConversationMessagesRequest) (*ConversationMessagesReply, error)
+	UpdateDMSettings(context.Context, *DMSettingsRequest) (*DMSettingsReply, error)
+	WhoIsPrimary(context.Context, *WhoisPrimaryRequest) (*WhoIsPrimaryResponse, error)
+	HeartBeat(context.Context, *HeartBeatRequest) (*HeartBeatResponse, error)
+	Prepare(context.Context, *PrepareArgs) (*PrepareReply, error)
+	Recovery(context.Context, *RecoveryArgs) (*RecoveryReply, error)
+	ViewChange(context.Context, *ViewChangeArgs) (*ViewChangeReply, error)
+	PromptViewChange(context.Context, *PromptViewChangeArgs) (*PromptViewChangeReply, error)
+	StartView(context.Context, *StartViewArgs) (*StartViewReply, error)
+	LogHash(context.Context, *LogHashArgs) (*LogHashReply, error)
+	// Admin RPC comparing this server's log with the other replicas
+	CompareLogs(context.Context, *CompareLogsArgs) (*CompareLogsReply, error)
+	StateDigest(context.Context, *StateDigestArgs) (*StateDigestReply, error)
+	StateRange(context.Context, *StateRangeArgs) (*StateRangeReply, error)
+}
+
+func RegisterGreeterServer(s *grpc.Server, srv GreeterServer) {
+	s.RegisterService(&_Greeter_serviceDesc, srv)
+}
+
+func _Greeter_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).SayHello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/SayHello",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).SayHello(ctx, req.(*HelloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_SayHelloAgain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HelloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).SayHelloAgain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/SayHelloAgain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).SayHelloAgain(ctx, req.(*HelloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Credentials)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).Register(ctx, req.(*Credentials))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Credentials)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).Login(ctx, req.(*Credentials))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_UserExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).UserExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UserExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UserExists(ctx, req.(*UserExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_AddTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).AddTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/AddTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).AddTweet(ctx, req.(*AddTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_OwnTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnTweetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).OwnTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/OwnTweets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).OwnTweets(ctx, req.(*OwnTweetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_DeleteTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).DeleteTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/DeleteTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).DeleteTweet(ctx, req.(*DeleteTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_EditTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).EditTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/EditTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).EditTweet(ctx, req.(*EditTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_Retweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetweetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).Retweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/Retweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).Retweet(ctx, req.(*RetweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/GetConversation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetConversation(ctx, req.(*ConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_LikeTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).LikeTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/LikeTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).LikeTweet(ctx, req.(*LikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-type GreeterClient interface {
-	// Sends a greeting
-	SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
-	SayHelloAgain(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
-	Register(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*RegisterReply, error)
-	Login(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*LoginReply, error)
-	UserExists(ctx context.Context, in *UserExistsRequest, opts ...grpc.CallOption) (*UserExistsReply, error)
-	AddTweet(ctx context.Context, in *AddTweetRequest, opts ...grpc.CallOption) (*AddTweetReply, error)
-	OwnTweets(ctx context.Context, in *OwnTweetsRequest, opts ...grpc.CallOption) (*OwnTweetsReply, error)
-	DeleteUser(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*DeleteReply, error)
-	UsersToFollow(ctx context.Context, in *UsersToFollowRequest, opts ...grpc.CallOption) (*UsersToFollowResponse, error)
-	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error)
-	GetFriendsTweets(ctx context.Context, in *GetFriendsTweetsRequest, opts ...grpc.CallOption) (*GetFriendsTweetsResponse, error)
-	WhoIsPrimary(ctx context.Context, in *WhoisPrimaryRequest, opts ...grpc.CallOption) (*WhoIsPrimaryResponse, error)
-	HeartBeat(ctx context.Context, in *HeartBeatRequest, opts ...grpc.CallOption) (*HeartBeatResponse, error)
-	Prepare(ctx context.Context, in *PrepareArgs, opts ...grpc.CallOption) (*PrepareReply, error)
-	Recovery(ctx context.Context, in *RecoveryArgs, opts ...grpc.CallOption) (*RecoveryReply, error)
-	ViewChange(ctx context.Context, in *ViewChangeArgs, opts ...grpc.CallOption) (*ViewChangeReply, error)
-	PromptViewChange(ctx context.Context, in *PromptViewChangeArgs, opts ...grpc.CallOption) (*PromptViewChangeReply, error)
-	StartView(ctx context.Context, in *StartViewArgs, opts ...grpc.CallOption) (*StartViewReply, error)
+func _Greeter_UnlikeTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).UnlikeTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UnlikeTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UnlikeTweet(ctx, req.(*LikeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type greeterClient struct {
-	cc *grpc.ClientConn
+func _Greeter_ListLikedTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HomeTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).ListLikedTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ListLikedTweets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ListLikedTweets(ctx, req.(*HomeTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ListNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ListNotifications(ctx, req.(*NotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/MarkNotificationsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Credentials)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).DeleteUser(ctx, req.(*Credentials))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Credentials)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/RestoreUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).RestoreUser(ctx, req.(*Credentials))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_UsersToFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsersToFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).UsersToFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UsersToFollow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UsersToFollow(ctx, req.(*UsersToFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/FollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UnfollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ListFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ListFollowing(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ListFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ListFollowers(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UpdateProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/GetProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/GetBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetBlob(ctx, req.(*BlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_UploadAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).UploadAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UploadAvatar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UploadAvatar(ctx, req.(*UploadAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_UploadMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).UploadMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UploadMedia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UploadMedia(ctx, req.(*UploadMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewGreeterClient(cc *grpc.ClientConn) GreeterClient {
-	return &greeterClient{cc}
+func _Greeter_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/VotePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
-	out := new(HelloReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/SayHello", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_ScheduleTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTweetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).ScheduleTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ScheduleTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ScheduleTweet(ctx, req.(*ScheduleTweetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) SayHelloAgain(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
-	out := new(HelloReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/SayHelloAgain", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_ListScheduledTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).ListScheduledTweets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ListScheduledTweets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ListScheduledTweets(ctx, req.(*ListScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) Register(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*RegisterReply, error) {
-	out := new(RegisterReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/Register", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_CancelScheduledTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).CancelScheduledTweet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/CancelScheduledTweet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).CancelScheduledTweet(ctx, req.(*CancelScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) Login(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*LoginReply, error) {
-	out := new(LoginReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/Login", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_CreateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).CreateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/CreateList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).CreateList(ctx, req.(*CreateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) UserExists(ctx context.Context, in *UserExistsRequest, opts ...grpc.CallOption) (*UserExistsReply, error) {
-	out := new(UserExistsReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/UserExists", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_DeleteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).DeleteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/DeleteList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).DeleteList(ctx, req.(*DeleteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) AddTweet(ctx context.Context, in *AddTweetRequest, opts ...grpc.CallOption) (*AddTweetReply, error) {
-	out := new(AddTweetReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/AddTweet", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_AddListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).AddListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/AddListMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).AddListMember(ctx, req.(*ListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) OwnTweets(ctx context.Context, in *OwnTweetsRequest, opts ...grpc.CallOption) (*OwnTweetsReply, error) {
-	out := new(OwnTweetsReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/OwnTweets", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_RemoveListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).RemoveListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/RemoveListMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).RemoveListMember(ctx, req.(*ListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) DeleteUser(ctx context.Context, in *Credentials, opts ...grpc.CallOption) (*DeleteReply, error) {
-	out := new(DeleteReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/DeleteUser", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_GetLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/GetLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetLists(ctx, req.(*GetListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) UsersToFollow(ctx context.Context, in *UsersToFollowRequest, opts ...grpc.CallOption) (*UsersToFollowResponse, error) {
-	out := new(UsersToFollowResponse)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/UsersToFollow", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetList(ctx, req.(*GetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserResponse, error) {
-	out := new(FollowUserResponse)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/FollowUser", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_GetListTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimelineRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetListTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/GetListTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetListTimeline(ctx, req.(*ListTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) GetFriendsTweets(ctx context.Context, in *GetFriendsTweetsRequest, opts ...grpc.CallOption) (*GetFriendsTweetsResponse, error) {
-	out := new(GetFriendsTweetsResponse)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/GetFriendsTweets", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequestDecision)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ApproveFollowRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ApproveFollowRequest(ctx, req.(*FollowRequestDecision))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) WhoIsPrimary(ctx context.Context, in *WhoisPrimaryRequest, opts ...grpc.CallOption) (*WhoIsPrimaryResponse, error) {
-	out := new(WhoIsPrimaryResponse)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/WhoIsPrimary", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequestDecision)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/RejectFollowRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).RejectFollowRequest(ctx, req.(*FollowRequestDecision))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) HeartBeat(ctx context.Context, in *HeartBeatRequest, opts ...grpc.CallOption) (*HeartBeatResponse, error) {
-	out := new(HeartBeatResponse)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/HeartBeat", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/ListFollowRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ListFollowRequests(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) Prepare(ctx context.Context, in *PrepareArgs, opts ...grpc.CallOption) (*PrepareReply, error) {
-	out := new(PrepareReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/Prepare", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_SetProtected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtectRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).SetProtected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/SetProtected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).SetProtected(ctx, req.(*ProtectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) Recovery(ctx context.Context, in *RecoveryArgs, opts ...grpc.CallOption) (*RecoveryReply, error) {
-	out := new(RecoveryReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/Recovery", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).BlockUser(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) ViewChange(ctx context.Context, in *ViewChangeArgs, opts ...grpc.CallOption) (*ViewChangeReply, error) {
-	out := new(ViewChangeReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/ViewChange", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UnblockUser(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) PromptViewChange(ctx context.Context, in *PromptViewChangeArgs, opts ...grpc.CallOption) (*PromptViewChangeReply, error) {
-	out := new(PromptViewChangeReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/PromptViewChange", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/MuteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).MuteUser(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) StartView(ctx context.Context, in *StartViewArgs, opts ...grpc.CallOption) (*StartViewReply, error) {
-	out := new(StartViewReply)
-	err := grpc.Invoke(ctx, "/helloworld.Greeter/StartView", in, out, c.cc, opts...)
-	if err != nil {
+func _Greeter_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for Greeter service
-
-type GreeterServer interface {
-	// Sends a greeting
-	SayHello(context.Context, *HelloRequest) (*HelloReply, error)
-	SayHelloAgain(context.Context, *HelloRequest) (*HelloReply, error)
-	Register(context.Context, *Credentials) (*RegisterReply, error)
-	Login(context.Context, *Credentials) (*LoginReply, error)
-	UserExists(context.Context, *UserExistsRequest) (*UserExistsReply, error)
-	AddTweet(context.Context, *AddTweetRequest) (*AddTweetReply, error)
-	OwnTweets(context.Context, *OwnTweetsRequest) (*OwnTweetsReply, error)
-	DeleteUser(context.Context, *Credentials) (*DeleteReply, error)
-	UsersToFollow(context.Context, *UsersToFollowRequest) (*UsersToFollowResponse, error)
-	FollowUser(context.Context, *FollowUserRequest) (*FollowUserResponse, error)
-	GetFriendsTweets(context.Context, *GetFriendsTweetsRequest) (*GetFriendsTweetsResponse, error)
-	WhoIsPrimary(context.Context, *WhoisPrimaryRequest) (*WhoIsPrimaryResponse, error)
-	HeartBeat(context.Context, *HeartBeatRequest) (*HeartBeatResponse, error)
-	Prepare(context.Context, *PrepareArgs) (*PrepareReply, error)
-	Recovery(context.Context, *RecoveryArgs) (*RecoveryReply, error)
-	ViewChange(context.Context, *ViewChangeArgs) (*ViewChangeReply, error)
-	PromptViewChange(context.Context, *PromptViewChangeArgs) (*PromptViewChangeReply, error)
-	StartView(context.Context, *StartViewArgs) (*StartViewReply, error)
-}
-
-func RegisterGreeterServer(s *grpc.Server, srv GreeterServer) {
-	s.RegisterService(&_Greeter_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(GreeterServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/UnmuteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).UnmuteUser(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_SayHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HelloRequest)
+func _Greeter_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).SayHello(ctx, in)
+		return srv.(GreeterServer).ListBlocked(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/SayHello",
+		FullMethod: "/helloworld.Greeter/ListBlocked",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).SayHello(ctx, req.(*HelloRequest))
+		return srv.(GreeterServer).ListBlocked(ctx, req.(*ListFollowsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_SayHelloAgain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HelloRequest)
+func _Greeter_ListMuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).SayHelloAgain(ctx, in)
+		return srv.(GreeterServer).ListMuted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/SayHelloAgain",
+		FullMethod: "/helloworld.Greeter/ListMuted",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).SayHelloAgain(ctx, req.(*HelloRequest))
+		return srv.(GreeterServer).ListMuted(ctx, req.(*ListFollowsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Credentials)
+func _Greeter_GetFriendsTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendsTweetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).Register(ctx, in)
+		return srv.(GreeterServer).GetFriendsTweets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/Register",
+		FullMethod: "/helloworld.Greeter/GetFriendsTweets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).Register(ctx, req.(*Credentials))
+		return srv.(GreeterServer).GetFriendsTweets(ctx, req.(*GetFriendsTweetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Credentials)
+func _Greeter_HomeTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HomeTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).Login(ctx, in)
+		return srv.(GreeterServer).HomeTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/Login",
+		FullMethod: "/helloworld.Greeter/HomeTimeline",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).Login(ctx, req.(*Credentials))
+		return srv.(GreeterServer).HomeTimeline(ctx, req.(*HomeTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_UserExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserExistsRequest)
+func _Greeter_TweetsByHashtag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashtagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).UserExists(ctx, in)
+		return srv.(GreeterServer).TweetsByHashtag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/UserExists",
+		FullMethod: "/helloworld.Greeter/TweetsByHashtag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).UserExists(ctx, req.(*UserExistsRequest))
+		return srv.(GreeterServer).TweetsByHashtag(ctx, req.(*HashtagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_AddTweet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTweetRequest)
+func _Greeter_GetTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrendsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).AddTweet(ctx, in)
+		return srv.(GreeterServer).GetTrends(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/AddTweet",
+		FullMethod: "/helloworld.Greeter/GetTrends",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).AddTweet(ctx, req.(*AddTweetRequest))
+		return srv.(GreeterServer).GetTrends(ctx, req.(*TrendsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_OwnTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OwnTweetsRequest)
+func _Greeter_SearchTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).OwnTweets(ctx, in)
+		return srv.(GreeterServer).SearchTweets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/OwnTweets",
+		FullMethod: "/helloworld.Greeter/SearchTweets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).OwnTweets(ctx, req.(*OwnTweetsRequest))
+		return srv.(GreeterServer).SearchTweets(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Credentials)
+func _Greeter_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).DeleteUser(ctx, in)
+		return srv.(GreeterServer).SendMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/DeleteUser",
+		FullMethod: "/helloworld.Greeter/SendMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).DeleteUser(ctx, req.(*Credentials))
+		return srv.(GreeterServer).SendMessage(ctx, req.(*SendMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_UsersToFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UsersToFollowRequest)
+func _Greeter_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).UsersToFollow(ctx, in)
+		return srv.(GreeterServer).ListConversations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/UsersToFollow",
+		FullMethod: "/helloworld.Greeter/ListConversations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).UsersToFollow(ctx, req.(*UsersToFollowRequest))
+		return srv.(GreeterServer).ListConversations(ctx, req.(*ListConversationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserRequest)
+func _Greeter_GetConversationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConversationMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).FollowUser(ctx, in)
+		return srv.(GreeterServer).GetConversationMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/FollowUser",
+		FullMethod: "/helloworld.Greeter/GetConversationMessages",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).FollowUser(ctx, req.(*FollowUserRequest))
+		return srv.(GreeterServer).GetConversationMessages(ctx, req.(*ConversationMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetFriendsTweets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFriendsTweetsRequest)
+func _Greeter_UpdateDMSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DMSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetFriendsTweets(ctx, in)
+		return srv.(GreeterServer).UpdateDMSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/helloworld.Greeter/GetFriendsTweets",
+		FullMethod: "/helloworld.Greeter/UpdateDMSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetFriendsTweets(ctx, req.(*GetFriendsTweetsRequest))
+		return srv.(GreeterServer).UpdateDMSettings(ctx, req.(*DMSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1398,6 +6383,78 @@ func _Greeter_StartView_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Greeter_LogHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogHashArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).LogHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/LogHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).LogHash(ctx, req.(*LogHashArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_CompareLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareLogsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).CompareLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/CompareLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).CompareLogs(ctx, req.(*CompareLogsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_StateDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateDigestArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).StateDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/StateDigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).StateDigest(ctx, req.(*StateDigestArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_StateRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRangeArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).StateRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/helloworld.Greeter/StateRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).StateRange(ctx, req.(*StateRangeArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Greeter_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "helloworld.Greeter",
 	HandlerType: (*GreeterServer)(nil),
@@ -1430,10 +6487,50 @@ var _Greeter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OwnTweets",
 			Handler:    _Greeter_OwnTweets_Handler,
 		},
+		{
+			MethodName: "DeleteTweet",
+			Handler:    _Greeter_DeleteTweet_Handler,
+		},
+		{
+			MethodName: "EditTweet",
+			Handler:    _Greeter_EditTweet_Handler,
+		},
+		{
+			MethodName: "Retweet",
+			Handler:    _Greeter_Retweet_Handler,
+		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _Greeter_GetConversation_Handler,
+		},
+		{
+			MethodName: "LikeTweet",
+			Handler:    _Greeter_LikeTweet_Handler,
+		},
+		{
+			MethodName: "UnlikeTweet",
+			Handler:    _Greeter_UnlikeTweet_Handler,
+		},
+		{
+			MethodName: "ListLikedTweets",
+			Handler:    _Greeter_ListLikedTweets_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _Greeter_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _Greeter_MarkNotificationsRead_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _Greeter_DeleteUser_Handler,
 		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _Greeter_RestoreUser_Handler,
+		},
 		{
 			MethodName: "UsersToFollow",
 			Handler:    _Greeter_UsersToFollow_Handler,
@@ -1442,10 +6539,158 @@ var _Greeter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FollowUser",
 			Handler:    _Greeter_FollowUser_Handler,
 		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _Greeter_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _Greeter_ListFollowing_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _Greeter_ListFollowers_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Greeter_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _Greeter_GetProfile_Handler,
+		},
+		{
+			MethodName: "GetBlob",
+			Handler:    _Greeter_GetBlob_Handler,
+		},
+		{
+			MethodName: "UploadAvatar",
+			Handler:    _Greeter_UploadAvatar_Handler,
+		},
+		{
+			MethodName: "UploadMedia",
+			Handler:    _Greeter_UploadMedia_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _Greeter_VotePoll_Handler,
+		},
+		{
+			MethodName: "ScheduleTweet",
+			Handler:    _Greeter_ScheduleTweet_Handler,
+		},
+		{
+			MethodName: "ListScheduledTweets",
+			Handler:    _Greeter_ListScheduledTweets_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTweet",
+			Handler:    _Greeter_CancelScheduledTweet_Handler,
+		},
+		{
+			MethodName: "CreateList",
+			Handler:    _Greeter_CreateList_Handler,
+		},
+		{
+			MethodName: "DeleteList",
+			Handler:    _Greeter_DeleteList_Handler,
+		},
+		{
+			MethodName: "AddListMember",
+			Handler:    _Greeter_AddListMember_Handler,
+		},
+		{
+			MethodName: "RemoveListMember",
+			Handler:    _Greeter_RemoveListMember_Handler,
+		},
+		{
+			MethodName: "GetLists",
+			Handler:    _Greeter_GetLists_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _Greeter_GetList_Handler,
+		},
+		{
+			MethodName: "GetListTimeline",
+			Handler:    _Greeter_GetListTimeline_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _Greeter_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _Greeter_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _Greeter_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "SetProtected",
+			Handler:    _Greeter_SetProtected_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Greeter_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Greeter_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _Greeter_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _Greeter_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _Greeter_ListBlocked_Handler,
+		},
+		{
+			MethodName: "ListMuted",
+			Handler:    _Greeter_ListMuted_Handler,
+		},
 		{
 			MethodName: "GetFriendsTweets",
 			Handler:    _Greeter_GetFriendsTweets_Handler,
 		},
+		{
+			MethodName: "HomeTimeline",
+			Handler:    _Greeter_HomeTimeline_Handler,
+		},
+		{
+			MethodName: "TweetsByHashtag",
+			Handler:    _Greeter_TweetsByHashtag_Handler,
+		},
+		{
+			MethodName: "GetTrends",
+			Handler:    _Greeter_GetTrends_Handler,
+		},
+		{
+			MethodName: "SearchTweets",
+			Handler:    _Greeter_SearchTweets_Handler,
+		},
+		{
+			MethodName: "SendMessage",
+			Handler:    _Greeter_SendMessage_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _Greeter_ListConversations_Handler,
+		},
+		{
+			MethodName: "GetConversationMessages",
+			Handler:    _Greeter_GetConversationMessages_Handler,
+		},
+		{
+			MethodName: "UpdateDMSettings",
+			Handler:    _Greeter_UpdateDMSettings_Handler,
+		},
 		{
 			MethodName: "WhoIsPrimary",
 			Handler:    _Greeter_WhoIsPrimary_Handler,
@@ -1474,6 +6719,22 @@ var _Greeter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartView",
 			Handler:    _Greeter_StartView_Handler,
 		},
+		{
+			MethodName: "LogHash",
+			Handler:    _Greeter_LogHash_Handler,
+		},
+		{
+			MethodName: "CompareLogs",
+			Handler:    _Greeter_CompareLogs_Handler,
+		},
+		{
+			MethodName: "StateDigest",
+			Handler:    _Greeter_StateDigest_Handler,
+		},
+		{
+			MethodName: "StateRange",
+			Handler:    _Greeter_StateRange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protodef.proto",
@@ -1482,82 +6743,308 @@ var _Greeter_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("protodef.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xff, 0x4e, 0x1b, 0xc7,
-	0x13, 0xb7, 0x63, 0x8c, 0xed, 0xc1, 0x36, 0x66, 0xbf, 0x84, 0xdc, 0xf7, 0x08, 0x2d, 0xdd, 0xa2,
-	0x14, 0xaa, 0xc8, 0x49, 0xa8, 0x22, 0x55, 0x51, 0x85, 0x62, 0x20, 0x21, 0x54, 0x34, 0xb1, 0xce,
-	0x24, 0xa8, 0x52, 0xa5, 0xe8, 0x62, 0x2f, 0xe6, 0xaa, 0xf3, 0x9d, 0xbb, 0xbb, 0xc6, 0xf0, 0x67,
-	0x1f, 0xa0, 0xcf, 0xd1, 0xc7, 0xe8, 0xab, 0x55, 0xbb, 0xb7, 0x77, 0xb7, 0x7b, 0xf6, 0x19, 0xd4,
-	0xff, 0x6e, 0x66, 0x3e, 0xb3, 0x3b, 0x3b, 0xbf, 0x0f, 0x9a, 0x63, 0x1a, 0xf2, 0x70, 0x40, 0x2e,
-	0xdb, 0xf2, 0x03, 0xc1, 0x15, 0xf1, 0xfd, 0x70, 0x1a, 0x52, 0x7f, 0x80, 0x31, 0xd4, 0xdf, 0x09,
-	0xca, 0x21, 0x7f, 0x4c, 0x08, 0xe3, 0x08, 0xc1, 0x52, 0xe0, 0x8e, 0x88, 0x55, 0xdc, 0x2e, 0xee,
-	0xd6, 0x1c, 0xf9, 0x8d, 0x9f, 0x00, 0x28, 0xcc, 0xd8, 0xbf, 0x45, 0x16, 0x54, 0x46, 0x84, 0x31,
-	0x77, 0x18, 0x83, 0x62, 0x12, 0xf7, 0x60, 0xe5, 0x88, 0x92, 0x01, 0x09, 0xb8, 0xe7, 0xfa, 0x0c,
-	0xad, 0x43, 0x79, 0xa2, 0x9d, 0x15, 0x11, 0xa8, 0x05, 0xa5, 0xf1, 0x74, 0x60, 0x3d, 0x90, 0x3c,
-	0xf1, 0x89, 0x1e, 0x43, 0xed, 0x0b, 0x0d, 0xdd, 0x41, 0xdf, 0x65, 0xdc, 0x2a, 0x6d, 0x17, 0x77,
-	0xab, 0x4e, 0xca, 0xc0, 0x7b, 0xd0, 0x70, 0xc8, 0xd0, 0x63, 0x9c, 0xd0, 0xbb, 0xee, 0xdf, 0x01,
-	0x38, 0x0b, 0x87, 0x5e, 0x10, 0xe1, 0x36, 0x60, 0x99, 0x71, 0x97, 0x4f, 0x98, 0x84, 0x55, 0x1d,
-	0x45, 0xe1, 0x3d, 0x58, 0xfd, 0xc8, 0x08, 0x7d, 0x73, 0xe3, 0x31, 0xce, 0x16, 0x43, 0x9f, 0xc1,
-	0x9a, 0x0e, 0x8d, 0x3c, 0x64, 0x43, 0x75, 0xc2, 0x08, 0xd5, 0x5e, 0x96, 0xd0, 0xf8, 0x77, 0x58,
-	0xed, 0x0c, 0x06, 0xe7, 0x53, 0x42, 0xf8, 0x3d, 0xe0, 0x68, 0x0b, 0x80, 0x0b, 0xec, 0x67, 0x4e,
-	0x6e, 0xb8, 0x72, 0x49, 0x4d, 0x72, 0xce, 0xc9, 0x0d, 0xbf, 0xc3, 0x31, 0xdf, 0x41, 0x23, 0xbd,
-	0x6b, 0xd1, 0x2b, 0x36, 0xa1, 0x2c, 0x51, 0x22, 0xb6, 0xf2, 0x22, 0x15, 0x5b, 0xf1, 0x8d, 0x3b,
-	0xd0, 0xfc, 0x30, 0x0d, 0xa4, 0x5c, 0x39, 0xe3, 0x19, 0x44, 0x26, 0x9c, 0x79, 0x4c, 0x40, 0x4b,
-	0xbb, 0x2b, 0xfb, 0x6b, 0xed, 0x34, 0x63, 0xda, 0xd1, 0x8d, 0x29, 0x06, 0xb7, 0xa1, 0xa5, 0x1d,
-	0x71, 0xb7, 0x93, 0x5e, 0xc0, 0xca, 0x31, 0xf1, 0x09, 0x27, 0xd1, 0x7d, 0x18, 0xea, 0x03, 0x49,
-	0xf6, 0x74, 0xe3, 0x0d, 0x1e, 0xc6, 0xb0, 0x24, 0x02, 0xb1, 0xf0, 0xd8, 0x7d, 0x58, 0x17, 0x18,
-	0x76, 0x1e, 0xbe, 0x0d, 0x85, 0xb1, 0xf7, 0x31, 0xe5, 0x02, 0x1e, 0x66, 0x74, 0xd8, 0x38, 0x0c,
-	0x18, 0x41, 0x07, 0xb0, 0x36, 0xd1, 0x05, 0x9a, 0x33, 0x5a, 0xba, 0x33, 0x84, 0xb6, 0x33, 0x0b,
-	0xc5, 0x7f, 0x16, 0x61, 0x2d, 0x22, 0x25, 0x42, 0x99, 0x82, 0xa1, 0xce, 0x88, 0x7f, 0xf9, 0xd1,
-	0x34, 0xc7, 0xe0, 0xa1, 0xef, 0xa1, 0xc5, 0xc3, 0x54, 0x55, 0xe2, 0xa2, 0xcc, 0x98, 0xe1, 0xdf,
-	0x91, 0x20, 0x3f, 0x02, 0xd2, 0x4d, 0x50, 0x2f, 0xc3, 0x50, 0xbf, 0x94, 0x5c, 0xd3, 0xdd, 0x3a,
-	0x0f, 0xbf, 0x84, 0x47, 0x27, 0x84, 0xbf, 0xa5, 0x1e, 0x09, 0x06, 0xec, 0xfe, 0x81, 0xf5, 0xa0,
-	0x29, 0xbd, 0xd9, 0xf1, 0xfd, 0x48, 0x09, 0x3d, 0xcd, 0xa0, 0xe7, 0x79, 0x2f, 0x2d, 0x87, 0x3d,
-	0x58, 0x96, 0x59, 0xc5, 0xac, 0x07, 0x79, 0x69, 0xa7, 0x00, 0xf8, 0x37, 0xb0, 0x66, 0x2d, 0x54,
-	0x2f, 0x7c, 0x0d, 0x8d, 0x4b, 0x5d, 0xa0, 0xe2, 0x66, 0x67, 0x6f, 0x4e, 0xed, 0x74, 0x4c, 0x05,
-	0xcc, 0x60, 0xa5, 0x4b, 0xc9, 0xd8, 0xa5, 0xa4, 0x43, 0x87, 0x4c, 0xd4, 0xcd, 0x27, 0x8f, 0x4c,
-	0xe5, 0x0b, 0xca, 0x8e, 0xfc, 0x46, 0x3b, 0xd0, 0xe8, 0x52, 0x6f, 0xe4, 0xd2, 0xdb, 0xa3, 0x70,
-	0x34, 0xf2, 0xa2, 0xea, 0x2d, 0x3b, 0x26, 0x53, 0xb4, 0xc0, 0xd3, 0x60, 0x40, 0x6e, 0x64, 0x70,
-	0xca, 0x4e, 0x44, 0x08, 0xee, 0x9b, 0x80, 0xd3, 0x5b, 0x6b, 0x29, 0x6a, 0x8c, 0x92, 0xc0, 0x3f,
-	0x41, 0x5d, 0x5d, 0x1a, 0xd5, 0xc5, 0xbc, 0x5b, 0x2d, 0xa8, 0xf4, 0x26, 0xfd, 0x3e, 0x61, 0x4c,
-	0xde, 0x57, 0x75, 0x62, 0x12, 0xbf, 0x82, 0xba, 0x43, 0xfa, 0xe1, 0x35, 0xa1, 0xb7, 0xb9, 0x36,
-	0x6f, 0xc0, 0x72, 0x8f, 0xd0, 0x6b, 0x42, 0x95, 0xb1, 0x8a, 0xc2, 0x7f, 0x17, 0x45, 0x8f, 0x8d,
-	0x94, 0x17, 0xde, 0x2d, 0x0c, 0xf5, 0x48, 0x14, 0x9e, 0x9a, 0x13, 0x93, 0xb3, 0xbe, 0x28, 0xcd,
-	0xf3, 0x85, 0x66, 0xfb, 0x92, 0x61, 0x3b, 0xda, 0x85, 0xa5, 0x63, 0x97, 0xbb, 0x56, 0x59, 0xc6,
-	0x69, 0x3d, 0x1b, 0x27, 0x21, 0x73, 0x24, 0x02, 0xff, 0x55, 0x84, 0x6a, 0xcc, 0x12, 0xa9, 0x98,
-	0xa9, 0xa4, 0x84, 0x16, 0xb2, 0xae, 0xcb, 0xd8, 0x34, 0xa4, 0xf1, 0xa8, 0x49, 0x68, 0xd1, 0xe0,
-	0xce, 0x93, 0x06, 0x57, 0xca, 0x6d, 0x70, 0x09, 0x46, 0x58, 0x1e, 0x15, 0x92, 0xb0, 0x5c, 0xbe,
-	0x5c, 0x91, 0x78, 0x07, 0x9a, 0xc2, 0x37, 0x47, 0x57, 0x6e, 0x30, 0xcc, 0xcd, 0x15, 0x4c, 0x60,
-	0x35, 0x45, 0x45, 0x0e, 0x7e, 0x02, 0xcd, 0x33, 0x97, 0xf1, 0xf7, 0x21, 0x1d, 0xb9, 0xbe, 0xa6,
-	0x90, 0xe1, 0x8a, 0x69, 0x79, 0x16, 0x0e, 0x95, 0xc3, 0xc5, 0xa7, 0xee, 0xc6, 0x92, 0x99, 0x02,
-	0x2f, 0xa1, 0xd1, 0xe3, 0x2e, 0xe5, 0x42, 0x31, 0x37, 0x07, 0x66, 0x0e, 0xc4, 0x2d, 0x68, 0x26,
-	0x6a, 0xd2, 0x38, 0xfc, 0x10, 0xfe, 0x77, 0x71, 0x15, 0x7a, 0x4c, 0xc5, 0x4f, 0x95, 0x3e, 0x7e,
-	0x0a, 0xeb, 0x17, 0x57, 0xe1, 0x69, 0xca, 0x56, 0xf5, 0x96, 0x24, 0x79, 0x51, 0x4b, 0x72, 0x8c,
-	0xa0, 0xf5, 0x8e, 0xb8, 0x94, 0x1f, 0x12, 0x37, 0x9e, 0x85, 0xf8, 0x03, 0xac, 0x69, 0x3c, 0xa5,
-	0x6e, 0x41, 0xe5, 0x94, 0x75, 0x7c, 0xef, 0x9a, 0xa8, 0x5e, 0x14, 0x93, 0x68, 0x1b, 0x56, 0xfa,
-	0x13, 0x4a, 0x49, 0x20, 0x6d, 0x53, 0x49, 0xab, 0xb3, 0xf0, 0x73, 0x58, 0xef, 0xd2, 0x70, 0x34,
-	0xe6, 0x99, 0x28, 0x58, 0x50, 0x79, 0x4f, 0xa6, 0xda, 0xe3, 0x63, 0x12, 0xbf, 0x80, 0x87, 0x59,
-	0x8d, 0x64, 0xad, 0x88, 0xfd, 0x5a, 0x34, 0xfc, 0xba, 0xff, 0x0f, 0x40, 0xe5, 0x84, 0x12, 0xc2,
-	0x09, 0x45, 0x07, 0x50, 0xed, 0xb9, 0xb7, 0x72, 0x1b, 0x42, 0x96, 0x9e, 0x34, 0xfa, 0x12, 0x65,
-	0x6f, 0xcc, 0x91, 0x08, 0xc7, 0x16, 0xd0, 0x11, 0x34, 0x62, 0xfd, 0xce, 0xd0, 0xf5, 0x82, 0xff,
-	0x74, 0xc8, 0x6b, 0xa8, 0xc6, 0x2b, 0x11, 0x7a, 0xa4, 0xa3, 0xb4, 0xed, 0xcb, 0xfe, 0xbf, 0x2e,
-	0x30, 0x36, 0x28, 0x5c, 0x40, 0xaf, 0xa0, 0x2c, 0x37, 0xa5, 0x7c, 0x75, 0xe3, 0xf6, 0x74, 0xab,
-	0xc2, 0x05, 0xf4, 0x33, 0x40, 0xba, 0x14, 0xa1, 0xad, 0x6c, 0xb5, 0x1a, 0xcb, 0x92, 0xbd, 0x99,
-	0x27, 0x8e, 0xce, 0x3a, 0x86, 0x6a, 0xbc, 0xc3, 0x20, 0x03, 0x9a, 0xd9, 0xa2, 0xcc, 0xd7, 0x18,
-	0x6b, 0x0f, 0x2e, 0xa0, 0x13, 0xa8, 0x25, 0x0b, 0x08, 0x7a, 0xac, 0x23, 0xb3, 0x7b, 0x89, 0x6d,
-	0xe7, 0x48, 0x63, 0xc7, 0x42, 0xb4, 0x99, 0xc8, 0x65, 0x23, 0xd7, 0x37, 0x86, 0x40, 0x5b, 0x65,
-	0x70, 0x01, 0x7d, 0x82, 0x86, 0xb1, 0x50, 0xa0, 0xed, 0x99, 0xa9, 0x93, 0xd9, 0x4f, 0xec, 0x6f,
-	0x16, 0x20, 0xa2, 0x12, 0xc1, 0x05, 0xf4, 0x0b, 0x40, 0x3a, 0xcb, 0x4d, 0xa7, 0xcf, 0xac, 0x19,
-	0xf6, 0x57, 0x79, 0xe2, 0xe4, 0xb8, 0xcf, 0xd0, 0xca, 0x8e, 0x4f, 0xf4, 0xad, 0xae, 0x95, 0x33,
-	0xfe, 0xed, 0x9d, 0xc5, 0xa0, 0xe4, 0x82, 0x1e, 0xd4, 0xf5, 0x5e, 0x81, 0xbe, 0xd6, 0xf5, 0xe6,
-	0x34, 0x17, 0x7b, 0x3b, 0x03, 0x98, 0x69, 0x33, 0x32, 0xf3, 0x6a, 0x49, 0xfb, 0x30, 0xe3, 0x9c,
-	0xed, 0x34, 0xf6, 0x56, 0x8e, 0x34, 0x39, 0xeb, 0x00, 0x2a, 0x6a, 0xda, 0x9a, 0x71, 0xd6, 0xe6,
-	0xbe, 0x6d, 0xcd, 0x11, 0xc4, 0x81, 0xee, 0x88, 0x1a, 0x8c, 0x46, 0xa6, 0x59, 0xc3, 0xfa, 0x14,
-	0xce, 0x16, 0xa1, 0x36, 0x62, 0x65, 0xda, 0x42, 0xda, 0x84, 0x90, 0x91, 0x99, 0x66, 0x3b, 0x33,
-	0xab, 0x28, 0xd3, 0xb8, 0x70, 0x01, 0xfd, 0x0a, 0xad, 0x6c, 0x4f, 0x33, 0xf3, 0x6e, 0x5e, 0x8f,
-	0x34, 0xf3, 0x6e, 0x6e, 0x4f, 0x94, 0x05, 0x5a, 0x4b, 0x86, 0x03, 0x32, 0x5e, 0x63, 0x8c, 0x1a,
-	0xb3, 0xae, 0x32, 0xe3, 0xa4, 0x70, 0xf8, 0x1c, 0x36, 0xbd, 0xb0, 0x3d, 0xa4, 0xe3, 0x7e, 0x9b,
-	0xdc, 0xb8, 0xa3, 0xb1, 0x4f, 0x98, 0x86, 0x3f, 0x5c, 0x95, 0xdd, 0xed, 0x42, 0x7c, 0x77, 0xc5,
-	0x0f, 0x6a, 0xb7, 0xf8, 0x65, 0x59, 0xfe, 0xa9, 0xfe, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xf6, 0xe1, 0x6d, 0x45, 0xbb, 0x0e, 0x00, 0x00,
+	// 4841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3c, 0x4d, 0x73, 0xdc, 0x46,
+	0x76, 0x9a, 0x2f, 0xce, 0xcc, 0x9b, 0x0f, 0x92, 0x20, 0x45, 0x41, 0xd0, 0x87, 0xa9, 0xb6, 0x2c,
+	0xcb, 0x2e, 0x45, 0xf6, 0x7a, 0x63, 0x97, 0x13, 0x7b, 0x15, 0x93, 0xa2, 0x25, 0x2b, 0x4b, 0x4a,
+	0x0c, 0x48, 0x59, 0x95, 0x8f, 0x5a, 0x2e, 0x38, 0x68, 0x0e, 0x11, 0xcd, 0x00, 0x63, 0x00, 0x43,
+	0x8a, 0xb5, 0x55, 0xb9, 0xe6, 0xb4, 0x55, 0xa9, 0xad, 0xca, 0x2d, 0xb9, 0xe5, 0x92, 0x43, 0x4e,
+	0xf9, 0x38, 0xe6, 0x94, 0x43, 0xce, 0x49, 0x8e, 0xf9, 0x01, 0x39, 0xa5, 0x2a, 0xb7, 0x1c, 0x53,
+	0xaf, 0x3f, 0x80, 0x6e, 0x0c, 0x80, 0x19, 0x4b, 0xf2, 0x26, 0xb9, 0xcd, 0xfb, 0xe8, 0xd7, 0xaf,
+	0x5f, 0x77, 0xbf, 0x7e, 0xfd, 0xfa, 0x61, 0xa0, 0x3f, 0x09, 0x83, 0x38, 0x70, 0xe9, 0xc9, 0x7d,
+	0xf6, 0xc3, 0x80, 0x53, 0x3a, 0x1a, 0x05, 0xe7, 0x41, 0x38, 0x72, 0x09, 0x81, 0xee, 0x37, 0x08,
+	0xd9, 0xf4, 0xbb, 0x29, 0x8d, 0x62, 0xc3, 0x80, 0xba, 0xef, 0x8c, 0xa9, 0x59, 0xd9, 0xac, 0xdc,
+	0x6d, 0xdb, 0xec, 0x37, 0xb9, 0x03, 0x20, 0x78, 0x26, 0xa3, 0x0b, 0xc3, 0x84, 0xe6, 0x98, 0x46,
+	0x91, 0x33, 0x94, 0x4c, 0x12, 0x24, 0x7f, 0x5a, 0x81, 0xce, 0xc3, 0x90, 0xba, 0xd4, 0x8f, 0x3d,
+	0x67, 0x14, 0x19, 0xeb, 0xd0, 0x98, 0x2a, 0xc2, 0x38, 0x60, 0xac, 0x40, 0x6d, 0x72, 0xee, 0x9a,
+	0x55, 0x86, 0xc3, 0x9f, 0xc6, 0x75, 0x68, 0x1f, 0x87, 0x81, 0xe3, 0x0e, 0x9c, 0x28, 0x36, 0x6b,
+	0x9b, 0x95, 0xbb, 0x2d, 0x3b, 0x45, 0xa0, 0x94, 0xc9, 0x34, 0x1c, 0x52, 0xb3, 0xce, 0x28, 0x1c,
+	0xc0, 0x36, 0xb1, 0x37, 0xa6, 0x51, 0xec, 0x8c, 0x27, 0x66, 0x63, 0xb3, 0x72, 0xb7, 0x66, 0xa7,
+	0x08, 0xf2, 0x01, 0xf4, 0x6c, 0x3a, 0xf4, 0xa2, 0x98, 0x86, 0xf3, 0x94, 0xbe, 0x0d, 0xb0, 0x1b,
+	0x0c, 0x3d, 0x9f, 0xf3, 0x6d, 0xc0, 0x52, 0x14, 0x3b, 0xf1, 0x34, 0x62, 0x6c, 0x2d, 0x5b, 0x40,
+	0xe4, 0x03, 0x58, 0x7e, 0x1e, 0xd1, 0xf0, 0xeb, 0x57, 0x5e, 0x14, 0x47, 0xe5, 0xac, 0x1f, 0xc1,
+	0xaa, 0xca, 0xca, 0xcd, 0x6a, 0x41, 0x6b, 0x1a, 0xd1, 0x50, 0xb1, 0x46, 0x02, 0x93, 0x7f, 0xa9,
+	0xc2, 0xf2, 0x96, 0xeb, 0x1e, 0x9e, 0x53, 0x1a, 0x2f, 0xc0, 0x6f, 0xdc, 0x00, 0x88, 0x91, 0xf7,
+	0x28, 0xa6, 0xaf, 0x62, 0x61, 0xc7, 0x36, 0xc3, 0x1c, 0xd2, 0x57, 0xf1, 0x1c, 0x6b, 0x5e, 0x85,
+	0x16, 0x6f, 0xec, 0xb9, 0xcc, 0xa0, 0x35, 0xbb, 0xc9, 0xe0, 0x27, 0x6e, 0xb9, 0x49, 0xb1, 0x61,
+	0x88, 0xe3, 0x3e, 0x8a, 0x03, 0x73, 0x89, 0x37, 0x64, 0xf0, 0x61, 0x60, 0xbc, 0x0f, 0x8d, 0x31,
+	0x75, 0x3d, 0xc7, 0x6c, 0x6e, 0xd6, 0xee, 0x76, 0x3e, 0x59, 0xbd, 0x9f, 0xae, 0xaf, 0xfb, 0x7b,
+	0x48, 0xb0, 0x39, 0xdd, 0xb8, 0x05, 0xdd, 0x49, 0x30, 0x1a, 0x1d, 0x05, 0x93, 0xd8, 0x0b, 0xfc,
+	0xc8, 0x6c, 0x6d, 0xd6, 0xee, 0xb6, 0xed, 0x0e, 0xe2, 0x9e, 0x71, 0x94, 0xf1, 0x2e, 0xf4, 0x18,
+	0x8b, 0x3b, 0x0d, 0x1d, 0xc4, 0x98, 0x6d, 0xd6, 0x17, 0x6b, 0xb7, 0x23, 0x70, 0x28, 0x27, 0x1a,
+	0x9c, 0x52, 0x77, 0x3a, 0xa2, 0x2e, 0x0e, 0x04, 0x18, 0x4f, 0x27, 0xc1, 0x3d, 0x71, 0xc9, 0x36,
+	0xf4, 0x52, 0x9b, 0x96, 0x4c, 0x97, 0x66, 0x90, 0xaa, 0x66, 0x10, 0xf2, 0x77, 0x75, 0x68, 0x30,
+	0x09, 0xb8, 0x2b, 0x98, 0xb1, 0xc5, 0xae, 0xc0, 0xdf, 0x46, 0x1f, 0xaa, 0x49, 0x93, 0xaa, 0x97,
+	0x31, 0x5f, 0x2d, 0x6b, 0xbe, 0x0d, 0x58, 0x72, 0xa6, 0xf1, 0x69, 0x10, 0x32, 0xab, 0xb7, 0x6d,
+	0x01, 0x19, 0x1f, 0x41, 0xf3, 0xd4, 0x8b, 0xe2, 0x20, 0xbc, 0x30, 0x1b, 0xcc, 0x7a, 0x97, 0x55,
+	0xeb, 0xb1, 0xde, 0xbf, 0x76, 0xbd, 0xd8, 0x96, 0x5c, 0xc6, 0x35, 0x68, 0x53, 0xd7, 0x8b, 0xa9,
+	0x7b, 0xe4, 0xc4, 0x62, 0x22, 0x5a, 0x1c, 0xb1, 0xc5, 0xf6, 0xca, 0xc8, 0x7b, 0x49, 0x23, 0xb3,
+	0xb9, 0x59, 0xb9, 0xdb, 0xb0, 0x39, 0x20, 0xb1, 0xae, 0xd9, 0xe2, 0x3b, 0x88, 0x01, 0xb8, 0x8c,
+	0x42, 0xca, 0x87, 0x1e, 0x9c, 0x08, 0x33, 0xb7, 0x05, 0xe6, 0xd9, 0x09, 0xda, 0xe5, 0xbb, 0x69,
+	0x10, 0x53, 0x24, 0x72, 0xfb, 0x36, 0x19, 0xfc, 0xec, 0xc4, 0xf8, 0x0d, 0x68, 0x05, 0xa1, 0x37,
+	0xf4, 0x7c, 0x67, 0x64, 0x76, 0x36, 0x2b, 0xd9, 0x29, 0xe7, 0x46, 0x4f, 0x58, 0x70, 0x2d, 0x0b,
+	0xb1, 0x91, 0xd9, 0x65, 0x7a, 0x25, 0x30, 0x9a, 0x85, 0x49, 0x8d, 0xcc, 0x1e, 0xa3, 0x08, 0x48,
+	0x5b, 0x6d, 0x7d, 0x7d, 0xb5, 0x99, 0xc0, 0x7e, 0x7a, 0x34, 0x32, 0x97, 0x59, 0x1b, 0x09, 0x62,
+	0x47, 0x63, 0xea, 0xf3, 0xa5, 0xb5, 0xc2, 0x96, 0x56, 0x02, 0x23, 0xed, 0xd4, 0x89, 0x4e, 0x63,
+	0x67, 0x18, 0x99, 0xab, 0x9c, 0x26, 0xe1, 0x74, 0xfd, 0x1a, 0x73, 0xd6, 0xef, 0x6d, 0xa8, 0xe3,
+	0x3a, 0x34, 0xd7, 0xd8, 0xa0, 0x57, 0x54, 0xbe, 0xfd, 0x60, 0x34, 0xb2, 0x19, 0x95, 0xfc, 0x65,
+	0x05, 0xea, 0x08, 0x1a, 0x1f, 0x43, 0x53, 0xae, 0xf4, 0x0a, 0x93, 0xbc, 0x91, 0x6d, 0xc1, 0x57,
+	0xbd, 0x2d, 0xd9, 0x70, 0x4e, 0xe8, 0xab, 0x89, 0x17, 0xd2, 0x08, 0x67, 0x97, 0xaf, 0xad, 0xb6,
+	0xc0, 0x6c, 0xc5, 0x68, 0xad, 0xc1, 0x28, 0x88, 0xa8, 0x2b, 0xf6, 0xb5, 0x80, 0x70, 0x82, 0xcf,
+	0x82, 0x98, 0xba, 0xd2, 0x45, 0x32, 0x80, 0x71, 0x9f, 0x06, 0xde, 0x80, 0xb2, 0xcd, 0xdc, 0xb0,
+	0x05, 0x44, 0x3e, 0x03, 0x48, 0xfb, 0xce, 0x5d, 0xda, 0x42, 0x5e, 0xc4, 0x34, 0x68, 0x70, 0x79,
+	0x11, 0xf9, 0x8b, 0x0a, 0x2c, 0x7f, 0x1b, 0xc4, 0x94, 0x0d, 0x75, 0x01, 0x3f, 0x55, 0xbc, 0xb3,
+	0x50, 0x35, 0x3e, 0x64, 0x36, 0x90, 0x86, 0x2d, 0x20, 0xdd, 0x77, 0xd5, 0xb3, 0xbe, 0xab, 0xdc,
+	0xe7, 0xef, 0x41, 0x2f, 0xd5, 0xae, 0x6c, 0xc7, 0xcb, 0x59, 0xac, 0x96, 0xce, 0xe2, 0xdf, 0x54,
+	0x61, 0xfd, 0x40, 0x38, 0x94, 0xb7, 0xe5, 0x9a, 0x6f, 0x00, 0x4c, 0xa6, 0xc7, 0x23, 0x2f, 0x3a,
+	0xc5, 0xe9, 0x15, 0x3e, 0x42, 0x60, 0xb6, 0xe2, 0x74, 0x1d, 0xd6, 0xbf, 0xa7, 0x1f, 0x6d, 0x2c,
+	0xe0, 0x47, 0x97, 0x72, 0xfc, 0xa8, 0x66, 0xee, 0x66, 0xd6, 0xdc, 0xdc, 0xc1, 0xb5, 0xf2, 0x1d,
+	0x5c, 0x3b, 0x6b, 0xfe, 0x2f, 0xc1, 0xc8, 0x98, 0xab, 0x6c, 0x0e, 0x32, 0xce, 0x93, 0xfc, 0x67,
+	0x05, 0xfa, 0xb2, 0x39, 0xf7, 0xda, 0x82, 0xa5, 0x92, 0x74, 0xff, 0xff, 0xcc, 0xb6, 0x37, 0x00,
+	0x06, 0x21, 0x75, 0x84, 0xa3, 0x6e, 0x72, 0x7d, 0x04, 0x66, 0x2b, 0x26, 0x9f, 0xc0, 0xfa, 0xae,
+	0x17, 0xc5, 0xc9, 0x98, 0x17, 0x09, 0x14, 0x9e, 0x82, 0x91, 0x69, 0x83, 0x26, 0xfe, 0x1c, 0xda,
+	0xc9, 0xc1, 0x27, 0xfc, 0x8c, 0xa5, 0x8e, 0x4e, 0x37, 0xab, 0x9d, 0x32, 0x93, 0x63, 0xd8, 0x78,
+	0xe8, 0xf8, 0x03, 0x3a, 0xfa, 0x3e, 0x5a, 0xe4, 0x9d, 0x7b, 0xc5, 0xf1, 0x06, 0xb9, 0x0f, 0xeb,
+	0x33, 0x7d, 0x94, 0x45, 0x4f, 0xff, 0x56, 0x81, 0xd5, 0x87, 0xcc, 0x4a, 0x38, 0xd4, 0x45, 0xf4,
+	0x91, 0x11, 0x6b, 0x35, 0x8d, 0x58, 0x8d, 0x4d, 0xe8, 0xb8, 0x34, 0x1a, 0x84, 0x5e, 0xea, 0x64,
+	0xda, 0xb6, 0x8a, 0xc2, 0x53, 0x64, 0x12, 0x7a, 0x67, 0x4e, 0x2c, 0xe3, 0x4a, 0x09, 0xea, 0xe3,
+	0x69, 0x64, 0x37, 0xc5, 0x15, 0x68, 0x8e, 0xbc, 0x88, 0xf9, 0x34, 0x3e, 0xeb, 0x4b, 0x08, 0x66,
+	0xa3, 0xa7, 0x66, 0x76, 0x77, 0x6c, 0xc3, 0xb2, 0x3a, 0xaa, 0xb2, 0xad, 0xa1, 0xf4, 0x50, 0x55,
+	0x7b, 0x20, 0x27, 0xb0, 0xba, 0x43, 0x47, 0x74, 0x71, 0xcb, 0x14, 0x49, 0x9a, 0x33, 0x65, 0x1f,
+	0xc0, 0xb2, 0xda, 0x4f, 0xd9, 0x6c, 0xfd, 0x09, 0xac, 0x22, 0xd3, 0x1e, 0x1d, 0x1f, 0xd3, 0xf0,
+	0x8d, 0x54, 0xda, 0x80, 0xa5, 0x31, 0x93, 0x22, 0x26, 0x4b, 0x40, 0xe5, 0x27, 0x02, 0xaa, 0xaa,
+	0xf6, 0x5f, 0xa6, 0xea, 0xd7, 0xb0, 0xfc, 0x98, 0xc6, 0xbb, 0x0b, 0x06, 0xe5, 0x28, 0xe6, 0xcc,
+	0xa3, 0xe7, 0x34, 0x14, 0xeb, 0x4a, 0x40, 0xe4, 0x0b, 0xe8, 0xa5, 0x62, 0xb0, 0xbf, 0x0f, 0x31,
+	0xb8, 0x8a, 0x62, 0x79, 0xc4, 0xaf, 0xab, 0x5b, 0x0f, 0xef, 0x01, 0xcc, 0x88, 0x9c, 0x85, 0x6c,
+	0x41, 0x5f, 0x34, 0x96, 0x2a, 0x28, 0xf6, 0xa8, 0x64, 0xed, 0x91, 0xdb, 0xff, 0xbf, 0x57, 0xa0,
+	0x25, 0xc5, 0xce, 0xb8, 0xc8, 0x75, 0x68, 0x04, 0xe7, 0x7e, 0xd2, 0x86, 0x03, 0xc9, 0x06, 0xa9,
+	0x15, 0x6f, 0x90, 0x7a, 0xe9, 0x06, 0x69, 0xe8, 0x1b, 0x44, 0xf7, 0x6c, 0x4b, 0x19, 0xcf, 0x86,
+	0x0e, 0x94, 0xcf, 0xdd, 0xd1, 0x20, 0x98, 0xfa, 0xb1, 0x08, 0x45, 0x3b, 0x1c, 0xf7, 0x10, 0x51,
+	0xfc, 0x36, 0x86, 0xa0, 0xbc, 0x02, 0x48, 0x90, 0xc4, 0xb0, 0x86, 0x23, 0x3b, 0xf4, 0xc6, 0x74,
+	0xe4, 0xf9, 0xf4, 0x75, 0xcd, 0xc4, 0x43, 0xde, 0xb1, 0x17, 0x8b, 0xf8, 0x82, 0x03, 0xc8, 0x3d,
+	0x98, 0x86, 0x51, 0x1a, 0x84, 0x73, 0x88, 0x7c, 0x0a, 0x0d, 0x76, 0x06, 0x28, 0x06, 0x6d, 0x27,
+	0x47, 0xde, 0xe9, 0x74, 0x7c, 0xec, 0x3b, 0xde, 0x28, 0x39, 0x72, 0x24, 0x82, 0xfc, 0x53, 0x05,
+	0xfa, 0x36, 0x8f, 0x64, 0xdf, 0x30, 0x1e, 0xd2, 0xcf, 0xb6, 0x5a, 0xe9, 0x95, 0x6e, 0x26, 0x2c,
+	0xda, 0x84, 0xae, 0x4f, 0xcf, 0x8f, 0x12, 0xd9, 0x3c, 0x32, 0x02, 0x9f, 0x9e, 0x1f, 0xe6, 0xdd,
+	0xec, 0x96, 0xb2, 0xbe, 0x69, 0x0b, 0xba, 0xc9, 0x28, 0x5e, 0xf3, 0xa6, 0xb4, 0x0b, 0x6b, 0x0f,
+	0x03, 0xff, 0x8c, 0x86, 0x11, 0x3b, 0xfc, 0xde, 0xcc, 0x1a, 0x24, 0x82, 0x15, 0x55, 0xda, 0xd3,
+	0xc0, 0xa5, 0x78, 0x7e, 0x33, 0x32, 0x93, 0x93, 0x7b, 0xe1, 0xe0, 0x74, 0xe3, 0xb3, 0xf4, 0x7a,
+	0x50, 0x65, 0x3b, 0xf2, 0xba, 0xca, 0x9a, 0x95, 0x9b, 0x5c, 0x1e, 0xc8, 0xd7, 0xb0, 0xaa, 0x0f,
+	0x01, 0x4d, 0xf1, 0x31, 0xd4, 0xc3, 0x20, 0x90, 0x9d, 0x96, 0x4b, 0x62, 0x9c, 0xe4, 0x27, 0xd0,
+	0x4e, 0x2e, 0x6d, 0xb9, 0xb1, 0xb5, 0x36, 0x17, 0xd5, 0xec, 0x5c, 0x78, 0x60, 0x70, 0xdf, 0x7b,
+	0xf8, 0x16, 0x56, 0x55, 0xb9, 0x9b, 0xff, 0x10, 0x56, 0xb4, 0xae, 0xca, 0x9c, 0xe7, 0x5f, 0x55,
+	0x60, 0x05, 0x47, 0x74, 0xf8, 0xbf, 0xbd, 0xd6, 0xcb, 0xaf, 0x00, 0x77, 0xa1, 0xaf, 0x68, 0x59,
+	0x36, 0xa0, 0xbf, 0xae, 0x40, 0x67, 0xd7, 0x7b, 0x49, 0x7f, 0x48, 0x0b, 0xeb, 0xca, 0xd6, 0x33,
+	0xca, 0x1a, 0xef, 0xc3, 0xb2, 0x1f, 0xc4, 0xde, 0x89, 0x37, 0x60, 0x6b, 0x28, 0xdd, 0xb9, 0x7d,
+	0x15, 0xfd, 0xc4, 0x25, 0xbf, 0x05, 0x6d, 0xae, 0x6a, 0xd9, 0xe6, 0x4c, 0x6e, 0xfe, 0x55, 0xe5,
+	0xe6, 0x4f, 0xfe, 0xb1, 0x02, 0xdd, 0xa7, 0x8a, 0xb4, 0x99, 0x13, 0xc3, 0x80, 0xfa, 0x4b, 0xcf,
+	0x97, 0xd9, 0x38, 0xf6, 0x1b, 0x45, 0x39, 0x83, 0x38, 0x90, 0x27, 0x31, 0x07, 0x5e, 0x3f, 0x71,
+	0x64, 0x40, 0x3d, 0xa4, 0x0e, 0x0f, 0x97, 0x5a, 0x36, 0xfb, 0x9d, 0xee, 0xe6, 0x66, 0xf9, 0x6e,
+	0x26, 0x3f, 0x87, 0x75, 0x55, 0xff, 0x85, 0x8e, 0xee, 0xc4, 0xf7, 0x57, 0xf3, 0x7d, 0x7f, 0x4d,
+	0xf3, 0xfd, 0xbf, 0xac, 0x80, 0x91, 0xe9, 0x02, 0xed, 0xfc, 0x00, 0x7a, 0xea, 0x34, 0xc8, 0xe3,
+	0xdd, 0x54, 0x35, 0x55, 0x9b, 0xd9, 0x3a, 0xbb, 0xf1, 0x0e, 0x74, 0x7c, 0xfa, 0x2a, 0x3e, 0x12,
+	0x7d, 0x72, 0xfb, 0x02, 0xa2, 0x1e, 0x32, 0x0c, 0xea, 0x33, 0xf5, 0x99, 0x61, 0xc4, 0x15, 0x98,
+	0x43, 0x64, 0x0c, 0xd7, 0xf7, 0x9c, 0xf0, 0x65, 0x46, 0x25, 0x67, 0xa1, 0xd0, 0x7c, 0x0d, 0x1a,
+	0xd3, 0x09, 0xa6, 0x4c, 0xf8, 0x32, 0xad, 0x4f, 0x27, 0x87, 0xc1, 0x1c, 0x2f, 0xb0, 0x0b, 0x56,
+	0x41, 0x77, 0x65, 0xab, 0x2d, 0x55, 0xbe, 0xaa, 0x29, 0xbf, 0x05, 0xfd, 0x67, 0xe7, 0x3e, 0x9b,
+	0x41, 0x61, 0xc7, 0x8f, 0x80, 0xef, 0x6d, 0x3c, 0xd5, 0x85, 0x0d, 0x73, 0x66, 0x3b, 0xe5, 0x21,
+	0x8f, 0x60, 0x45, 0x11, 0xf1, 0xfa, 0x81, 0xda, 0x8f, 0xa0, 0xc3, 0xdd, 0x1b, 0xd7, 0x83, 0x40,
+	0xd7, 0x65, 0xe0, 0x81, 0x3a, 0x1e, 0x0d, 0x47, 0x7e, 0x13, 0x0f, 0xc2, 0x28, 0x0e, 0x42, 0xd1,
+	0xe6, 0x36, 0xf4, 0x42, 0x0e, 0x6b, 0x8d, 0x74, 0x24, 0xf9, 0x0a, 0xea, 0x18, 0x90, 0x95, 0x2a,
+	0x79, 0x1d, 0xda, 0x98, 0x7a, 0xa7, 0x83, 0x98, 0x72, 0x93, 0xb5, 0xec, 0x14, 0x81, 0x77, 0x41,
+	0x94, 0x10, 0x1d, 0x06, 0x8f, 0x02, 0x34, 0xcc, 0x22, 0x77, 0xc1, 0x17, 0x70, 0x39, 0xd3, 0x26,
+	0x9a, 0x04, 0x7e, 0x44, 0x8d, 0x07, 0xb0, 0x3a, 0x55, 0x09, 0x8a, 0xe1, 0x57, 0xb2, 0xb1, 0xa9,
+	0x3d, 0xcb, 0x4a, 0xfe, 0xb9, 0x02, 0xab, 0x1c, 0x64, 0x1c, 0x42, 0x15, 0x02, 0xdd, 0x88, 0x8e,
+	0x4e, 0x9e, 0xeb, 0xea, 0x68, 0x38, 0xe3, 0x43, 0x58, 0x89, 0x83, 0xb4, 0xa9, 0x72, 0x29, 0x9b,
+	0xc1, 0xff, 0x7a, 0x1c, 0xa7, 0x0d, 0x86, 0x3a, 0x12, 0x61, 0x20, 0x02, 0xdd, 0x13, 0x86, 0xd5,
+	0x57, 0x82, 0x8a, 0x63, 0xc1, 0x2f, 0xf5, 0x5d, 0xcf, 0x1f, 0x8a, 0xd9, 0x92, 0x20, 0xbe, 0x71,
+	0xac, 0x3d, 0xf7, 0x4f, 0x5e, 0xcb, 0x40, 0xf7, 0xc1, 0x88, 0x03, 0xb5, 0xb1, 0x62, 0xa2, 0x1c,
+	0xca, 0x9c, 0x9d, 0xfb, 0x00, 0xd6, 0x75, 0x45, 0xc4, 0xf8, 0xee, 0x40, 0x7f, 0xea, 0xe7, 0x8c,
+	0x30, 0x83, 0x25, 0xff, 0x5d, 0x81, 0xf5, 0xe7, 0x13, 0xd7, 0x89, 0xe9, 0x7e, 0x18, 0x9c, 0x78,
+	0xa3, 0x85, 0xce, 0xc2, 0x5b, 0xd0, 0x75, 0xbd, 0x68, 0x32, 0x72, 0x2e, 0x8e, 0x14, 0xe5, 0x3b,
+	0x02, 0xf7, 0x54, 0xbc, 0xef, 0x1c, 0x7b, 0x81, 0xf0, 0xb2, 0xf8, 0x13, 0x05, 0x8e, 0x02, 0x3e,
+	0x2b, 0x22, 0xf0, 0x4e, 0x60, 0xb4, 0xf4, 0x39, 0x3d, 0x8e, 0x3c, 0x71, 0xcd, 0x68, 0xdb, 0x12,
+	0x64, 0x19, 0xf3, 0x33, 0x27, 0x76, 0x42, 0x73, 0x49, 0x64, 0xcc, 0x19, 0x84, 0xd9, 0x97, 0x90,
+	0x8e, 0x83, 0x33, 0x7a, 0x24, 0xc8, 0x3c, 0x71, 0xd5, 0xe5, 0xc8, 0x2d, 0xce, 0xa4, 0x99, 0xae,
+	0x95, 0x35, 0xdd, 0x3d, 0x30, 0x32, 0x23, 0x2f, 0x8b, 0x15, 0x76, 0xa0, 0xff, 0x3d, 0x2c, 0x54,
+	0xe4, 0x8f, 0xfe, 0xbc, 0x06, 0x4d, 0x21, 0xe6, 0xff, 0xba, 0x85, 0x35, 0x6f, 0xd5, 0xcc, 0x78,
+	0x2b, 0xa4, 0xf2, 0x75, 0xc4, 0xaf, 0x6f, 0xe8, 0xfe, 0x53, 0x44, 0x4a, 0xc5, 0xbd, 0xd3, 0x56,
+	0xa9, 0x9e, 0x3f, 0xc4, 0x1e, 0xc5, 0x43, 0x00, 0xf0, 0x73, 0x83, 0x43, 0xa8, 0xbf, 0x10, 0xe1,
+	0xb2, 0x17, 0x85, 0x96, 0x9d, 0xc0, 0x28, 0x31, 0xe4, 0x76, 0xa7, 0x2e, 0x7b, 0x3f, 0x68, 0xd9,
+	0x29, 0xc2, 0x78, 0x0f, 0xfa, 0x9c, 0x33, 0x3a, 0x12, 0x66, 0xef, 0x71, 0x27, 0x2d, 0xb0, 0xdf,
+	0x32, 0x24, 0x1a, 0xe1, 0x78, 0x14, 0x0c, 0xf0, 0x11, 0xa4, 0xcf, 0x37, 0xb4, 0x00, 0xc9, 0x8f,
+	0xa1, 0xb3, 0x3d, 0x0a, 0x8e, 0xe5, 0xd4, 0x66, 0x6f, 0x80, 0x18, 0x4c, 0x04, 0x03, 0x67, 0x24,
+	0xfc, 0x00, 0x07, 0xc8, 0x36, 0xb4, 0x79, 0x23, 0x5c, 0x37, 0x06, 0xd4, 0x5d, 0x27, 0x76, 0x58,
+	0xa3, 0xae, 0xcd, 0x7e, 0xe3, 0x2c, 0x0e, 0x02, 0x3f, 0xa6, 0x7e, 0x7c, 0x14, 0x5f, 0x4c, 0x92,
+	0x59, 0x14, 0xb8, 0xc3, 0x8b, 0x09, 0x25, 0x03, 0x58, 0x7b, 0x3e, 0x19, 0x05, 0x8e, 0xcb, 0x97,
+	0xec, 0x82, 0xa9, 0x2e, 0xd6, 0x53, 0x55, 0xe9, 0xa9, 0xdc, 0x49, 0xbc, 0x0b, 0xab, 0x7a, 0x27,
+	0xa8, 0x70, 0x66, 0x8c, 0xe4, 0x18, 0x0c, 0xce, 0xc4, 0x13, 0xa1, 0x3f, 0x88, 0x22, 0x5f, 0xc0,
+	0x8a, 0xd6, 0x07, 0xea, 0x91, 0xe4, 0x64, 0x73, 0xee, 0x74, 0x6a, 0x4e, 0x96, 0xfc, 0x43, 0x05,
+	0x2e, 0x6b, 0x47, 0xe3, 0x0e, 0x1d, 0x78, 0x11, 0x2e, 0xee, 0x39, 0x87, 0xae, 0x5c, 0x27, 0x72,
+	0x33, 0xa6, 0x88, 0x5f, 0xcf, 0x09, 0x74, 0x0f, 0x0c, 0x4d, 0xef, 0x72, 0x47, 0x73, 0xca, 0x1c,
+	0x0d, 0x6e, 0xb3, 0x45, 0xe6, 0xa0, 0x34, 0xa6, 0x98, 0x33, 0x1b, 0x77, 0xa0, 0x9b, 0xf4, 0x54,
+	0xa6, 0xd1, 0xcf, 0xa1, 0xbb, 0x8d, 0xfb, 0x64, 0x41, 0xc7, 0x17, 0x3b, 0xe1, 0x90, 0xca, 0xdc,
+	0xbc, 0x80, 0xe6, 0x68, 0x72, 0x1b, 0x40, 0xf4, 0x50, 0xa6, 0xc7, 0xcf, 0x78, 0xe6, 0x9b, 0xdb,
+	0xf2, 0x07, 0xb8, 0x04, 0x88, 0xb4, 0x53, 0x22, 0x3f, 0x39, 0x4a, 0x1b, 0x28, 0x30, 0x2a, 0x8c,
+	0x9f, 0x38, 0x79, 0x7e, 0xb0, 0xbf, 0x0e, 0x0d, 0x9e, 0x0c, 0x13, 0xe9, 0x28, 0x06, 0x90, 0x4f,
+	0xe1, 0xca, 0x63, 0x1a, 0x3f, 0x0a, 0x3d, 0xea, 0xbb, 0xd1, 0xc2, 0x11, 0x2f, 0xf1, 0xa0, 0x8f,
+	0x9d, 0x47, 0x5b, 0xa3, 0x11, 0x6f, 0x64, 0xdc, 0xcb, 0x70, 0xe7, 0xa9, 0x9a, 0x9a, 0xe6, 0x83,
+	0xc4, 0x09, 0x57, 0x8b, 0xe2, 0x71, 0xc1, 0x40, 0xfe, 0x08, 0xcc, 0x59, 0x0d, 0x85, 0x71, 0xbe,
+	0x82, 0xde, 0x89, 0x4a, 0xc8, 0x7b, 0x7b, 0xd0, 0xf5, 0xb4, 0xf5, 0x06, 0xe4, 0x08, 0xd6, 0xbe,
+	0x09, 0xc6, 0x34, 0x9b, 0xec, 0x7b, 0x7b, 0xd3, 0x7a, 0x0c, 0xeb, 0x7a, 0x07, 0x42, 0xf5, 0xd4,
+	0x02, 0x95, 0x39, 0x16, 0x98, 0x3b, 0xb5, 0x24, 0x86, 0xfe, 0x37, 0xfc, 0x21, 0x79, 0x11, 0xfd,
+	0x4d, 0x68, 0x8a, 0x67, 0x67, 0x21, 0x4a, 0x82, 0xdf, 0x33, 0x63, 0xb9, 0x0b, 0xbd, 0xc3, 0x10,
+	0x4d, 0x29, 0x3b, 0x4d, 0x9a, 0x57, 0xd4, 0xe6, 0xef, 0x41, 0xff, 0xdc, 0xf3, 0xdd, 0xe0, 0xfc,
+	0x68, 0xec, 0xf9, 0xd3, 0xf4, 0x45, 0xb7, 0xc7, 0xb1, 0x7b, 0x1c, 0x49, 0x0e, 0xa0, 0xc1, 0xa4,
+	0xa9, 0xea, 0x55, 0x74, 0xf5, 0x36, 0x94, 0x45, 0xa3, 0x9e, 0xdc, 0x26, 0x34, 0x79, 0x25, 0x43,
+	0x24, 0x14, 0x97, 0x20, 0xf9, 0x1c, 0x3a, 0x52, 0x45, 0xdc, 0xda, 0x68, 0x73, 0x06, 0xe6, 0xda,
+	0x1c, 0x29, 0xb6, 0x60, 0x20, 0x7f, 0x5b, 0x81, 0xde, 0x01, 0x75, 0xc2, 0xc1, 0xe9, 0x82, 0x4b,
+	0xe2, 0xbb, 0x29, 0x0d, 0x2f, 0x64, 0xd2, 0x9b, 0x01, 0x4a, 0xbd, 0x45, 0x4d, 0xab, 0xb7, 0x58,
+	0x87, 0x46, 0xe4, 0xf9, 0x03, 0x2a, 0x9c, 0x3a, 0x07, 0x78, 0xa5, 0x52, 0xec, 0x8d, 0x84, 0x1b,
+	0xe7, 0x40, 0x6a, 0xd3, 0xa5, 0xfc, 0x29, 0x69, 0x6a, 0x53, 0x12, 0x40, 0x47, 0x2a, 0x2d, 0xc7,
+	0xfb, 0x96, 0xd6, 0x18, 0x2a, 0x12, 0x07, 0xb1, 0x33, 0x92, 0x6b, 0x83, 0x01, 0xe4, 0x57, 0x15,
+	0xe8, 0xed, 0x78, 0x21, 0x1d, 0xc4, 0x7b, 0xbc, 0x96, 0x69, 0x26, 0xbb, 0xf3, 0x3e, 0x2c, 0x0f,
+	0x94, 0x34, 0x65, 0x9a, 0xc0, 0xea, 0xab, 0x68, 0x9e, 0x46, 0x8f, 0xa8, 0xef, 0xa6, 0xaf, 0x2f,
+	0x1c, 0x4a, 0x12, 0x98, 0xf5, 0xa2, 0x04, 0xe6, 0x4c, 0x0a, 0xee, 0x15, 0xf4, 0x77, 0xf6, 0xd4,
+	0xdc, 0xe8, 0x8c, 0x52, 0x4a, 0xf2, 0xbf, 0xaa, 0x25, 0xff, 0x8d, 0x2f, 0xa1, 0x3b, 0x72, 0xa2,
+	0xf8, 0x48, 0x56, 0x6a, 0xd5, 0x98, 0x2b, 0xbb, 0xaa, 0x1a, 0x4e, 0x1b, 0xaf, 0xdd, 0x41, 0x76,
+	0x01, 0x90, 0xff, 0xa8, 0x80, 0x71, 0x40, 0x7d, 0x57, 0x12, 0x17, 0x58, 0x3a, 0x37, 0x01, 0x42,
+	0x3a, 0xf0, 0x26, 0x1e, 0xf5, 0x63, 0xa9, 0x8d, 0x82, 0xc9, 0xb3, 0x5f, 0x2d, 0xd7, 0x7e, 0x05,
+	0x76, 0x2a, 0x79, 0x47, 0xbc, 0x01, 0x20, 0x86, 0x99, 0x3e, 0x25, 0xb6, 0x05, 0x66, 0xee, 0x6b,
+	0x62, 0x08, 0x2b, 0xda, 0x48, 0xcb, 0x52, 0x35, 0x0b, 0xaf, 0x01, 0x5d, 0xa3, 0x5a, 0x46, 0x23,
+	0xe2, 0x82, 0x89, 0x47, 0xa4, 0x3a, 0xb5, 0x3f, 0xc0, 0x41, 0xfc, 0x0b, 0xd8, 0xc8, 0xe9, 0x05,
+	0xc7, 0xf7, 0x15, 0xf4, 0x54, 0x85, 0x73, 0x8f, 0x1b, 0x7d, 0xe5, 0xd9, 0x7a, 0x83, 0xf9, 0xae,
+	0xfc, 0xcf, 0x2a, 0x70, 0x4d, 0x15, 0x20, 0xec, 0xbb, 0xd0, 0x30, 0x17, 0x36, 0xf3, 0xf7, 0xf3,
+	0xf3, 0xbf, 0xac, 0xc0, 0xd5, 0x7c, 0x95, 0xd0, 0x26, 0x9f, 0x62, 0xc1, 0x13, 0x47, 0x08, 0x73,
+	0x94, 0x6c, 0x96, 0x84, 0x75, 0xbe, 0xbf, 0x51, 0xb6, 0x68, 0x4d, 0x7f, 0x9f, 0x3b, 0x85, 0xd5,
+	0x9d, 0xbd, 0x03, 0x1a, 0xc7, 0x9e, 0x3f, 0x8c, 0x16, 0x4c, 0x9e, 0x07, 0x13, 0xea, 0x1f, 0xb9,
+	0xe3, 0x48, 0xa6, 0x52, 0x10, 0xde, 0x19, 0x47, 0x0b, 0xbc, 0x42, 0x2b, 0x3d, 0x95, 0x45, 0x87,
+	0x58, 0x77, 0xba, 0x1f, 0xd2, 0x89, 0x13, 0xd2, 0xad, 0x70, 0x18, 0xe1, 0x6e, 0xc4, 0x6b, 0x9f,
+	0x38, 0x0a, 0xd9, 0x6f, 0xcc, 0xe5, 0xed, 0x87, 0xde, 0xd8, 0x09, 0x2f, 0x1e, 0x06, 0xe3, 0x74,
+	0x39, 0xea, 0x48, 0x9c, 0x9c, 0x27, 0xbe, 0x4b, 0x5f, 0xc9, 0xc9, 0x61, 0x00, 0x62, 0xbf, 0xf6,
+	0xe3, 0xf0, 0x42, 0xcc, 0x0d, 0x07, 0xb0, 0x17, 0x3c, 0xf8, 0xc5, 0xa5, 0x9a, 0xfd, 0x26, 0x5f,
+	0x42, 0x57, 0x28, 0x92, 0x5c, 0x0d, 0x67, 0x34, 0x31, 0xa1, 0x79, 0x30, 0x1d, 0x0c, 0x68, 0x94,
+	0x18, 0x44, 0x80, 0x64, 0x1f, 0xf3, 0x8f, 0x83, 0xe0, 0x8c, 0x86, 0x17, 0x85, 0xe3, 0xd8, 0x80,
+	0xa5, 0x03, 0x1a, 0x9e, 0x89, 0x1b, 0x4d, 0xc3, 0x16, 0x10, 0xea, 0xf8, 0x34, 0xc0, 0x73, 0x8d,
+	0x6f, 0x5c, 0x0e, 0x90, 0x7f, 0xad, 0x40, 0x4f, 0x8a, 0x2c, 0xd6, 0xe8, 0x3e, 0x34, 0x71, 0x48,
+	0xe9, 0x93, 0x99, 0xf6, 0x88, 0xbd, 0x1b, 0x0c, 0xd9, 0x80, 0x6d, 0xc9, 0x34, 0x6b, 0xcb, 0x5a,
+	0x9e, 0x2d, 0x95, 0x71, 0xd6, 0xb5, 0x71, 0x1a, 0x77, 0xa1, 0xbe, 0x83, 0xb7, 0xc7, 0x46, 0xfe,
+	0x8b, 0x39, 0xd2, 0x6c, 0xc6, 0x91, 0x8e, 0x6a, 0x49, 0x1d, 0xd5, 0xe7, 0xd0, 0x92, 0x4a, 0x61,
+	0x2f, 0xd8, 0x9f, 0xe3, 0xcb, 0x0b, 0xad, 0x04, 0x93, 0xf9, 0xa9, 0x2a, 0xf3, 0xf3, 0xab, 0x26,
+	0x7f, 0x3d, 0x67, 0xc2, 0x2d, 0xfe, 0x5b, 0x5d, 0xb6, 0x12, 0x46, 0xda, 0xbe, 0x13, 0x45, 0xe7,
+	0x41, 0x28, 0xdf, 0x46, 0x12, 0x18, 0x53, 0xda, 0x87, 0x49, 0x4a, 0xbb, 0x56, 0x98, 0xd2, 0x4e,
+	0x78, 0x50, 0x47, 0x71, 0xb3, 0x60, 0xd5, 0x47, 0x6d, 0x5b, 0x82, 0xb8, 0x05, 0x78, 0x92, 0xda,
+	0xdd, 0x8a, 0xe5, 0x59, 0x9a, 0x20, 0x70, 0xf4, 0xbb, 0xec, 0x4d, 0x67, 0x69, 0xb3, 0x86, 0xa3,
+	0x67, 0x00, 0xbe, 0x4c, 0x68, 0xd9, 0x7a, 0xb3, 0x39, 0xef, 0x65, 0x42, 0x63, 0x37, 0xee, 0xc1,
+	0xea, 0x4c, 0xb6, 0x5f, 0x54, 0x79, 0xcd, 0x12, 0x50, 0xf7, 0x67, 0xb8, 0x5f, 0xf7, 0x22, 0x96,
+	0xcd, 0x69, 0xd9, 0x12, 0x44, 0x87, 0xac, 0xb9, 0x69, 0x13, 0xe6, 0x3b, 0x64, 0xad, 0x01, 0xba,
+	0x2f, 0xe9, 0xcf, 0xcc, 0xce, 0x5c, 0xf7, 0x25, 0x59, 0x71, 0x0b, 0xb0, 0x2b, 0x23, 0x56, 0x93,
+	0xa2, 0x35, 0x05, 0x84, 0xe6, 0xda, 0x9b, 0xf2, 0x52, 0x52, 0x44, 0x73, 0x00, 0x4d, 0xbc, 0x9f,
+	0x5c, 0x93, 0x79, 0xee, 0x27, 0x45, 0x60, 0xb2, 0x54, 0xbb, 0xa0, 0x63, 0x4d, 0x29, 0x36, 0xce,
+	0x60, 0xb1, 0x5e, 0x62, 0x27, 0xcd, 0xc0, 0x99, 0x2b, 0x3c, 0x9d, 0xb3, 0xa3, 0x27, 0xe5, 0xb6,
+	0xbd, 0xc0, 0x5c, 0x65, 0x14, 0xfc, 0x89, 0x6b, 0x68, 0x57, 0x26, 0xe5, 0x0c, 0xbe, 0x86, 0x76,
+	0x95, 0xa4, 0xdc, 0x0b, 0x91, 0x94, 0x5b, 0xe3, 0xcb, 0xf6, 0x45, 0x9a, 0x94, 0xe3, 0xb9, 0x1a,
+	0x73, 0x9d, 0x9f, 0x04, 0x1c, 0xc2, 0x58, 0x85, 0xff, 0x62, 0x5b, 0xe7, 0x32, 0x4b, 0xbc, 0x28,
+	0x18, 0xbc, 0xab, 0x62, 0x4a, 0x2a, 0x32, 0x37, 0x66, 0xef, 0xaa, 0x48, 0xb0, 0x39, 0x19, 0xeb,
+	0x55, 0xbe, 0x65, 0xb5, 0x9d, 0x57, 0x66, 0x77, 0x1f, 0x96, 0x3f, 0x22, 0xd1, 0xe6, 0x2c, 0x58,
+	0x5a, 0x96, 0x94, 0x6d, 0x99, 0xe6, 0xfc, 0xd2, 0xb2, 0x04, 0xc6, 0x5e, 0x76, 0x59, 0x55, 0xcc,
+	0xd5, 0xb2, 0xaa, 0x18, 0xc6, 0x42, 0xbe, 0x84, 0x96, 0xec, 0x18, 0xed, 0x22, 0x8a, 0x16, 0x44,
+	0xc4, 0x28, 0x41, 0xb4, 0x0b, 0xaf, 0xbf, 0x93, 0x8e, 0x8f, 0x43, 0xe4, 0x43, 0xa8, 0xe3, 0xc0,
+	0x30, 0xcc, 0x7c, 0x92, 0x24, 0xb5, 0x78, 0x48, 0xb6, 0xa3, 0xa4, 0xa8, 0xf0, 0x37, 0xb9, 0x0d,
+	0x7d, 0x74, 0x78, 0x0f, 0x4f, 0x1d, 0x7f, 0x58, 0x78, 0x54, 0x90, 0x5f, 0xc0, 0x72, 0xca, 0xc5,
+	0xbd, 0xe6, 0x1d, 0xe8, 0xef, 0x3a, 0x51, 0xfc, 0x34, 0x08, 0xc7, 0xce, 0x48, 0x69, 0x90, 0xc1,
+	0x1a, 0x77, 0xa0, 0xb6, 0x1b, 0x0c, 0x4b, 0xbd, 0x28, 0x32, 0xa8, 0xbe, 0xb1, 0xa6, 0x9f, 0x01,
+	0x3f, 0x85, 0xde, 0x41, 0xec, 0x84, 0x31, 0x8a, 0x2b, 0x3c, 0x04, 0x16, 0xec, 0x86, 0xac, 0x40,
+	0x3f, 0x11, 0xc6, 0x06, 0x42, 0x2e, 0xc3, 0xda, 0x8b, 0xd3, 0xc0, 0x8b, 0x84, 0xab, 0x16, 0xeb,
+	0x9b, 0xdc, 0x83, 0xf5, 0x17, 0xa7, 0xc1, 0x93, 0x14, 0x2d, 0x2e, 0xca, 0xc9, 0x79, 0x58, 0x51,
+	0xce, 0x43, 0x62, 0xc0, 0xca, 0x37, 0xd4, 0x09, 0xe3, 0x6d, 0xea, 0xc8, 0x4c, 0x15, 0x79, 0x06,
+	0xab, 0x0a, 0x4e, 0x34, 0x37, 0xa1, 0xf9, 0x24, 0xda, 0x1a, 0x79, 0x67, 0x54, 0x9c, 0xd8, 0x12,
+	0xc4, 0xfd, 0x34, 0x98, 0x86, 0x21, 0xf5, 0x99, 0x6e, 0x62, 0x4a, 0x55, 0x14, 0xf9, 0x18, 0xd6,
+	0xf7, 0xc3, 0x60, 0x3c, 0x89, 0x33, 0x33, 0x66, 0x42, 0xf3, 0x29, 0x3d, 0x57, 0x4c, 0x22, 0x41,
+	0xf2, 0x23, 0xb8, 0x9c, 0x6d, 0x91, 0x7c, 0xfc, 0x21, 0xad, 0x5d, 0xd1, 0xad, 0x7d, 0x03, 0x3a,
+	0xbb, 0xc1, 0x10, 0x8f, 0x06, 0x26, 0xbb, 0x0f, 0xd5, 0x67, 0x13, 0x21, 0xb6, 0xfa, 0x6c, 0x42,
+	0x76, 0xa1, 0x2b, 0xc8, 0xc9, 0xe1, 0xf9, 0x6c, 0xf2, 0x34, 0x90, 0x73, 0x81, 0xbf, 0xf3, 0x8e,
+	0x19, 0x34, 0xdb, 0xa3, 0x60, 0xea, 0xcb, 0x32, 0x6d, 0x0e, 0x90, 0x5b, 0xb0, 0xfc, 0x30, 0x18,
+	0x63, 0x70, 0xb0, 0x1b, 0x0c, 0xa3, 0xdc, 0x0e, 0xc7, 0xb0, 0xa2, 0xb0, 0x24, 0xd9, 0x5a, 0x95,
+	0x27, 0xb7, 0xc3, 0x4f, 0xa1, 0x85, 0xcc, 0xde, 0xc0, 0x89, 0xcc, 0xda, 0xac, 0x27, 0xdd, 0x0d,
+	0x86, 0x5c, 0xac, 0x17, 0x05, 0xbe, 0x9d, 0xb0, 0x92, 0xbf, 0xaf, 0x40, 0x4f, 0xa3, 0x29, 0xe1,
+	0x45, 0x45, 0x0b, 0x2f, 0xae, 0x43, 0xdb, 0xa6, 0xce, 0xe0, 0xd4, 0x39, 0x1e, 0x51, 0x99, 0x6c,
+	0x4c, 0x10, 0x89, 0x5d, 0x6a, 0x39, 0x76, 0xa9, 0x2b, 0x6a, 0x5a, 0xd0, 0xda, 0xf1, 0xce, 0x68,
+	0x38, 0xa4, 0xae, 0xb8, 0x11, 0x25, 0x30, 0xbe, 0x1e, 0x3e, 0xf2, 0xc2, 0x28, 0x16, 0x08, 0x3f,
+	0x7e, 0x36, 0x11, 0xf7, 0xee, 0x19, 0x3c, 0x59, 0x85, 0x65, 0x7c, 0xc4, 0xa2, 0x3b, 0xde, 0x90,
+	0x46, 0x31, 0x5a, 0x92, 0xf8, 0xb0, 0xa2, 0xa0, 0x8a, 0xa7, 0xeb, 0x1e, 0x4b, 0x75, 0x24, 0x91,
+	0xce, 0x86, 0x9e, 0x73, 0x0e, 0x5f, 0x8e, 0x28, 0x92, 0x6d, 0xce, 0x54, 0xb2, 0x4f, 0x3f, 0x03,
+	0x48, 0xd9, 0xb1, 0xa7, 0x9f, 0x7a, 0x49, 0x08, 0xc2, 0x7e, 0xf3, 0xd8, 0xc5, 0xa5, 0xf2, 0x5e,
+	0xc9, 0x01, 0xf2, 0x21, 0xdb, 0x92, 0x31, 0xb5, 0xd5, 0x05, 0xbd, 0x3d, 0x1d, 0xbc, 0x94, 0x99,
+	0x82, 0x86, 0x2d, 0x41, 0xe2, 0xc1, 0x72, 0xca, 0xcb, 0x87, 0x24, 0x43, 0xa7, 0xca, 0xdc, 0xd0,
+	0xa9, 0x30, 0xcc, 0xcc, 0x9b, 0xad, 0x4f, 0xfe, 0xeb, 0x03, 0x68, 0x3e, 0x0e, 0x29, 0x8d, 0x69,
+	0x68, 0x3c, 0x80, 0xd6, 0x81, 0x73, 0xc1, 0xbe, 0xf8, 0x32, 0xb4, 0xa8, 0x42, 0xfd, 0x50, 0xcc,
+	0xda, 0xc8, 0xa1, 0xa0, 0x87, 0xb9, 0x64, 0x3c, 0x84, 0x9e, 0x6c, 0xbf, 0x35, 0x74, 0x3c, 0xff,
+	0xb5, 0x84, 0x7c, 0x05, 0x2d, 0xf9, 0x05, 0x97, 0x71, 0x45, 0xe5, 0x52, 0x3e, 0x30, 0xb3, 0xb4,
+	0x45, 0xae, 0x7d, 0xf0, 0x45, 0x2e, 0x19, 0xbf, 0x0d, 0x0d, 0xf6, 0x61, 0x57, 0x71, 0xf3, 0x8d,
+	0xcc, 0x1e, 0x11, 0x1f, 0x81, 0x91, 0x4b, 0xc6, 0xef, 0x02, 0xa4, 0xdf, 0x70, 0x19, 0x37, 0xb2,
+	0x66, 0xd6, 0xbe, 0xed, 0xb2, 0xae, 0x15, 0x91, 0xb9, 0xac, 0x1d, 0x68, 0xc9, 0x2f, 0x91, 0x0c,
+	0x8d, 0x35, 0xf3, 0xcd, 0x97, 0x75, 0x35, 0x9f, 0xc8, 0xa5, 0x3c, 0x86, 0x76, 0x52, 0x16, 0x61,
+	0x68, 0x85, 0x68, 0xd9, 0x6a, 0x09, 0xcb, 0x2a, 0xa0, 0x72, 0x41, 0x7b, 0xb2, 0x2e, 0x82, 0x6b,
+	0x74, 0x53, 0x8b, 0xb8, 0x66, 0x4a, 0xcf, 0xac, 0xeb, 0x85, 0xf4, 0x44, 0xaf, 0xa4, 0xe4, 0x4a,
+	0xd7, 0x2b, 0x5b, 0x2f, 0x66, 0x59, 0x05, 0x54, 0x2e, 0x68, 0x0b, 0x9a, 0xa2, 0x0a, 0xd1, 0xb0,
+	0xf4, 0x69, 0x55, 0x0b, 0x2c, 0x2d, 0x33, 0x97, 0xc6, 0x45, 0x1c, 0xb0, 0x12, 0x5f, 0x2d, 0xf9,
+	0xf4, 0x4e, 0x51, 0xc9, 0x9e, 0x94, 0x77, 0xa3, 0x98, 0x81, 0x0b, 0xfd, 0x09, 0xaf, 0xbe, 0xe2,
+	0x03, 0xd4, 0x96, 0x92, 0x52, 0x3f, 0x66, 0x5d, 0x9e, 0x25, 0xf0, 0xe6, 0xbf, 0x03, 0x9d, 0xe7,
+	0xfe, 0xe8, 0x0d, 0x04, 0x7c, 0xcb, 0x4b, 0x9c, 0x11, 0xe5, 0x8a, 0xe9, 0xd7, 0x06, 0x95, 0x93,
+	0x41, 0xb7, 0x36, 0x8b, 0x19, 0xf8, 0xc9, 0x4c, 0x2e, 0x19, 0x2f, 0x78, 0xe9, 0xb6, 0x7e, 0x37,
+	0xd8, 0x2c, 0xba, 0x44, 0x24, 0x8b, 0xeb, 0x66, 0x09, 0x07, 0x57, 0xf8, 0x25, 0x5c, 0xce, 0xad,
+	0x28, 0x32, 0xee, 0x6a, 0xbe, 0xb6, 0xa4, 0xc6, 0xc9, 0xba, 0xb3, 0x00, 0xa7, 0x74, 0x13, 0xc0,
+	0x17, 0x25, 0x2b, 0xc1, 0x29, 0xdc, 0xe9, 0x57, 0x66, 0x57, 0xb1, 0x94, 0xb0, 0x0d, 0x1d, 0x51,
+	0xf4, 0x53, 0x2e, 0x22, 0xb3, 0xf0, 0xd2, 0x32, 0x21, 0x36, 0x47, 0x3d, 0xad, 0x18, 0x47, 0xb7,
+	0x63, 0x5e, 0x6d, 0x8f, 0x75, 0xab, 0x84, 0x23, 0x99, 0xa3, 0x3d, 0x80, 0xb4, 0x80, 0x45, 0x77,
+	0x43, 0x33, 0x25, 0x3a, 0xd6, 0xcd, 0x22, 0x72, 0x22, 0xee, 0x00, 0xba, 0x6a, 0xc5, 0x88, 0xbe,
+	0x8e, 0x72, 0x8a, 0x5a, 0xac, 0xcd, 0x62, 0x86, 0x44, 0xa8, 0x0d, 0xbd, 0xf4, 0xe9, 0x0c, 0xdf,
+	0xf8, 0x6f, 0xea, 0x2b, 0x39, 0xfb, 0x6a, 0x67, 0xbd, 0x53, 0x48, 0xcf, 0x97, 0x49, 0xc3, 0xe8,
+	0x6d, 0xc8, 0x3c, 0x80, 0x9e, 0x56, 0xf3, 0x91, 0x99, 0xa3, 0x9c, 0x42, 0x18, 0xeb, 0x66, 0x09,
+	0x87, 0xdc, 0xdd, 0xf0, 0x98, 0xc6, 0x52, 0xa2, 0xe6, 0xb7, 0x32, 0xb2, 0xd6, 0x72, 0x68, 0xe4,
+	0x92, 0xf1, 0x05, 0x34, 0x1f, 0xd3, 0x98, 0x5d, 0x60, 0xae, 0xcc, 0xdc, 0xd8, 0xf2, 0x5c, 0x43,
+	0x52, 0x76, 0x40, 0x2e, 0x19, 0xfb, 0xd0, 0x55, 0x1f, 0xf7, 0x33, 0xf3, 0x39, 0x5b, 0x5b, 0x60,
+	0xdd, 0x28, 0x66, 0x48, 0x0e, 0x07, 0xe5, 0x95, 0xde, 0xb8, 0x39, 0xcb, 0xaf, 0x96, 0x08, 0x58,
+	0xd7, 0x0b, 0xe9, 0xc9, 0xd1, 0x27, 0x3f, 0xc9, 0xd3, 0x8f, 0xbe, 0xcc, 0x67, 0x84, 0xd6, 0xd5,
+	0x7c, 0xa2, 0x74, 0xeb, 0x3d, 0xed, 0xcb, 0x32, 0x7d, 0xe6, 0xf2, 0xbe, 0xd1, 0xb3, 0x6e, 0x96,
+	0x70, 0x70, 0xa1, 0xbf, 0xcf, 0x5f, 0x7c, 0xf5, 0x1b, 0x6c, 0xc6, 0x01, 0xe6, 0x7d, 0xa0, 0x65,
+	0xdd, 0x2c, 0xe1, 0xe0, 0xa2, 0x7f, 0x36, 0xf3, 0xc9, 0x13, 0x57, 0x9b, 0x68, 0xae, 0x25, 0xf7,
+	0xc3, 0x2b, 0x6b, 0xb3, 0x94, 0x27, 0x09, 0x4e, 0xd2, 0x6f, 0x89, 0x74, 0xaf, 0x30, 0xf3, 0xe5,
+	0x94, 0x75, 0xad, 0x88, 0x9c, 0xc8, 0x4a, 0xbf, 0xf5, 0xd1, 0x65, 0xcd, 0x7c, 0x6b, 0x64, 0x5d,
+	0x2b, 0x22, 0xcb, 0xc5, 0x83, 0x9f, 0x5c, 0xa7, 0xdf, 0xe3, 0xe8, 0xe2, 0x66, 0xbe, 0x13, 0xb2,
+	0xae, 0x15, 0x91, 0xe5, 0xea, 0x5e, 0xb1, 0x59, 0x49, 0xd7, 0x5b, 0x93, 0xb8, 0x03, 0x2d, 0xf9,
+	0xed, 0x8e, 0xbe, 0x1c, 0x33, 0x1f, 0x06, 0x59, 0x57, 0xf3, 0x89, 0x32, 0x20, 0x68, 0x0a, 0x94,
+	0xbe, 0xe1, 0xf5, 0x2f, 0x7b, 0xac, 0xdc, 0x94, 0x07, 0x3f, 0xcf, 0x05, 0xa7, 0x3c, 0x94, 0x8d,
+	0x19, 0xef, 0xf5, 0x3a, 0xe7, 0xf9, 0x1f, 0xc2, 0xfa, 0xd6, 0x64, 0x12, 0x06, 0x67, 0x54, 0x2f,
+	0x22, 0xbd, 0x35, 0x7b, 0x2c, 0x64, 0x8a, 0x68, 0xac, 0x9b, 0x85, 0x2c, 0x72, 0xcc, 0x7f, 0x00,
+	0x6b, 0x36, 0xfd, 0x63, 0x3a, 0x88, 0x7f, 0x00, 0xd9, 0x2f, 0xd4, 0xda, 0x8e, 0x24, 0xe1, 0xf6,
+	0x16, 0x3c, 0xfe, 0x23, 0xe8, 0x1e, 0xd0, 0x38, 0xcd, 0xf5, 0x65, 0xdd, 0xb3, 0x52, 0x68, 0x63,
+	0x99, 0xb9, 0x34, 0xe9, 0xe4, 0xdb, 0x2c, 0xc3, 0xc8, 0xce, 0x4c, 0x33, 0xe3, 0x8c, 0x93, 0xda,
+	0x18, 0x6b, 0x23, 0x87, 0x22, 0x43, 0xdb, 0xce, 0x73, 0xff, 0xf8, 0x8d, 0x44, 0x3c, 0x80, 0x16,
+	0xa6, 0x33, 0x5f, 0xbb, 0xfd, 0x57, 0x00, 0xcf, 0xfd, 0xf1, 0x9b, 0x48, 0xd8, 0xc7, 0x0f, 0x26,
+	0xa2, 0x98, 0xe1, 0xa8, 0xfb, 0x36, 0xe6, 0xe7, 0x29, 0x46, 0xd6, 0x51, 0x8c, 0xe3, 0x7a, 0x2b,
+	0xf2, 0x8e, 0x60, 0x25, 0x5b, 0xac, 0x62, 0xbc, 0x9b, 0xd9, 0xa1, 0x79, 0xc5, 0x36, 0xd6, 0xed,
+	0x72, 0x26, 0x35, 0x7e, 0x52, 0x37, 0xdf, 0xdb, 0x89, 0xc3, 0x7f, 0x0f, 0x96, 0x79, 0x47, 0xdb,
+	0x17, 0xa2, 0x8e, 0x44, 0x5f, 0xa8, 0x7a, 0x71, 0xc9, 0x42, 0x22, 0xb7, 0xa0, 0xfd, 0x98, 0xc6,
+	0xbc, 0xf8, 0xc2, 0xb8, 0x3a, 0x53, 0x67, 0x91, 0x8c, 0xfb, 0x4a, 0x1e, 0x49, 0xba, 0xca, 0x2e,
+	0x2f, 0x66, 0x10, 0x76, 0xd4, 0xa4, 0x68, 0xb5, 0x19, 0xd6, 0x95, 0x3c, 0x52, 0x12, 0x4e, 0x28,
+	0xef, 0xd4, 0xfa, 0x1c, 0xcf, 0x3e, 0xd5, 0x5b, 0xd7, 0x0b, 0xe9, 0x5c, 0xdc, 0x11, 0xbf, 0xb2,
+	0xe8, 0x8f, 0x08, 0xb7, 0xb3, 0x0b, 0x23, 0xef, 0x85, 0xda, 0x22, 0x73, 0xb8, 0xe4, 0xd5, 0xe5,
+	0x4a, 0xe6, 0x02, 0x99, 0x3c, 0x3a, 0xbc, 0x5f, 0x74, 0x4f, 0xcc, 0x3c, 0x12, 0x5b, 0xef, 0xcd,
+	0x67, 0x4c, 0xce, 0x37, 0x1e, 0x53, 0xa6, 0xcf, 0x9c, 0x99, 0x03, 0x38, 0xfb, 0xd0, 0x6a, 0x5d,
+	0x2b, 0x22, 0xcb, 0x40, 0xa9, 0xab, 0x66, 0x71, 0xf5, 0xf5, 0x99, 0x93, 0xf6, 0xd5, 0x17, 0x53,
+	0x5e, 0x02, 0x98, 0x45, 0x08, 0xed, 0x24, 0xb1, 0xab, 0x5f, 0xf0, 0xb3, 0x39, 0x60, 0xeb, 0x46,
+	0x01, 0x35, 0x91, 0xf5, 0x00, 0x9a, 0xe2, 0x79, 0x54, 0x8f, 0x76, 0x95, 0xc7, 0x5b, 0xcb, 0xcc,
+	0x21, 0xa4, 0x8e, 0xb4, 0x25, 0x5f, 0x33, 0x8d, 0xcc, 0x7d, 0x2c, 0x7d, 0x36, 0xb5, 0xae, 0xe6,
+	0x51, 0xd2, 0x7c, 0x05, 0xa4, 0xe9, 0x61, 0x7d, 0xa7, 0xe9, 0x89, 0x66, 0xeb, 0x5a, 0x3e, 0x2d,
+	0x0d, 0x20, 0x57, 0xb2, 0xd9, 0x66, 0x3d, 0x7a, 0xcc, 0xcb, 0x5e, 0x5b, 0xb7, 0xca, 0x38, 0xd2,
+	0xcd, 0xd7, 0x4e, 0xd2, 0xf6, 0x99, 0x9d, 0xa7, 0x3e, 0x0d, 0x58, 0x56, 0x2e, 0x29, 0x3d, 0x32,
+	0x9a, 0x22, 0x79, 0x9d, 0xc9, 0x3a, 0xa4, 0x09, 0x6f, 0xcb, 0xcc, 0x21, 0xa4, 0xa1, 0x61, 0x47,
+	0xc9, 0x45, 0xeb, 0x01, 0x53, 0x26, 0x8f, 0x6d, 0x5d, 0x2f, 0x20, 0x2a, 0xb2, 0x94, 0xec, 0xac,
+	0x2e, 0x2b, 0x93, 0xc9, 0xb5, 0xae, 0x17, 0x10, 0x95, 0x19, 0x4c, 0xb3, 0xa2, 0x86, 0x35, 0xc3,
+	0x6d, 0xe7, 0xcf, 0x60, 0x26, 0x93, 0x4a, 0x2e, 0x6d, 0x7f, 0x0c, 0xd7, 0xbc, 0xe0, 0xfe, 0x30,
+	0x9c, 0x0c, 0xee, 0xd3, 0x57, 0xce, 0x78, 0x32, 0xa2, 0x91, 0xd2, 0x60, 0x7b, 0x99, 0xe5, 0x23,
+	0x5f, 0xe0, 0x6f, 0x0c, 0x08, 0x82, 0xfd, 0xca, 0xf1, 0x12, 0xfb, 0xff, 0xac, 0x1f, 0xff, 0xcf,
+	0x00, 0x1d, 0x14, 0x46, 0xe4, 0x51, 0x4b, 0x00, 0x00,
 }